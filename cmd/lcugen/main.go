@@ -0,0 +1,507 @@
+// lcugen League Client'ın OpenAPI (/help, swagger) dökümünden lcu paketi için
+// tipli istek fonksiyonları ve modeller üretir.
+//
+// Döküm, istemcinin system.yaml dosyasında enable_swagger: true açıkken
+// https://127.0.0.1:<port>/swagger/v3/openapi.json adresinden alınabilir.
+// Kullanım için internal/lcu/generate.go dosyasındaki go:generate satırına bakın.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Schema OpenAPI şema tanımı (sadece kullandığımız alanlar)
+type Schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 string             `json:"type"`
+	Format               string             `json:"format"`
+	Description          string             `json:"description"`
+	Enum                 []string           `json:"enum"`
+	Items                *Schema            `json:"items"`
+	Properties           map[string]*Schema `json:"properties"`
+	AdditionalProperties *Schema            `json:"additionalProperties"`
+}
+
+// Parameter endpoint parametresi
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"` // path, query
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+// MediaType istek/yanıt gövdesi
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Body requestBody ve response ortak yapısı
+type Body struct {
+	Content map[string]MediaType `json:"content"`
+}
+
+// Operation tek bir HTTP işlemi
+type Operation struct {
+	OperationID string          `json:"operationId"`
+	Summary     string          `json:"summary"`
+	Description string          `json:"description"`
+	Tags        []string        `json:"tags"`
+	Parameters  []Parameter     `json:"parameters"`
+	RequestBody *Body           `json:"requestBody"`
+	Responses   map[string]Body `json:"responses"`
+}
+
+// Document OpenAPI dökümanı
+type Document struct {
+	Paths      map[string]map[string]*Operation `json:"paths"`
+	Components struct {
+		Schemas map[string]*Schema `json:"schemas"`
+	} `json:"components"`
+}
+
+// endpoint üretilecek bir işlem
+type endpoint struct {
+	Method string
+	Path   string
+	Op     *Operation
+}
+
+func main() {
+	schemaPath := flag.String("schema", "schema/openapi.json", "OpenAPI döküm dosyası")
+	outDir := flag.String("out", ".", "üretilen dosyaların yazılacağı klasör")
+	pkg := flag.String("pkg", "lcu", "paket adı")
+	namespaces := flag.String("namespaces", "", "virgülle ayrılmış namespace listesi (ör. lol-gameflow,lol-perks)")
+	flag.Parse()
+
+	data, err := os.ReadFile(*schemaPath)
+	if err != nil {
+		log.Fatalf("şema okunamadı: %v", err)
+	}
+
+	var doc Document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		log.Fatalf("şema parse edilemedi: %v", err)
+	}
+
+	var selected []string
+	for _, ns := range strings.Split(*namespaces, ",") {
+		if ns = strings.TrimSpace(ns); ns != "" {
+			selected = append(selected, ns)
+		}
+	}
+	if len(selected) == 0 {
+		log.Fatal("en az bir namespace gerekli (-namespaces)")
+	}
+	sort.Strings(selected)
+
+	g := &generator{doc: &doc, pkg: *pkg, owner: make(map[string]string)}
+
+	// Önce her namespace'in endpointlerini topla ve şema sahipliğini belirle.
+	// Birden fazla namespace'in kullandığı şema, alfabetik olarak ilk namespace'e yazılır.
+	byNS := make(map[string][]endpoint)
+	for _, ns := range selected {
+		byNS[ns] = g.endpoints(ns)
+		if len(byNS[ns]) == 0 {
+			log.Fatalf("%s için endpoint bulunamadı", ns)
+		}
+		for _, ep := range byNS[ns] {
+			for _, s := range ep.schemas() {
+				g.claim(s, ns)
+			}
+		}
+	}
+
+	for _, ns := range selected {
+		src, err := g.file(ns, byNS[ns])
+		if err != nil {
+			log.Fatalf("%s üretilemedi: %v", ns, err)
+		}
+		name := filepath.Join(*outDir, strings.ReplaceAll(ns, "-", "_")+"_gen.go")
+		if err := os.WriteFile(name, src, 0o644); err != nil {
+			log.Fatalf("%s yazılamadı: %v", name, err)
+		}
+	}
+}
+
+type generator struct {
+	doc   *Document
+	pkg   string
+	owner map[string]string // şema adı -> namespace
+}
+
+// endpoints bir namespace'e ait işlemleri path ve metoda göre sıralı döner
+func (g *generator) endpoints(ns string) []endpoint {
+	tag := "Plugin " + ns
+	var eps []endpoint
+	for path, methods := range g.doc.Paths {
+		for method, op := range methods {
+			for _, t := range op.Tags {
+				if t == tag {
+					eps = append(eps, endpoint{Method: strings.ToUpper(method), Path: path, Op: op})
+					break
+				}
+			}
+		}
+	}
+	sort.Slice(eps, func(i, j int) bool {
+		if eps[i].Path != eps[j].Path {
+			return eps[i].Path < eps[j].Path
+		}
+		return eps[i].Method < eps[j].Method
+	})
+	return eps
+}
+
+// schemas işlemin doğrudan kullandığı şemalar
+func (ep endpoint) schemas() []*Schema {
+	var out []*Schema
+	for _, p := range ep.Op.Parameters {
+		out = append(out, p.Schema)
+	}
+	if s := ep.requestSchema(); s != nil {
+		out = append(out, s)
+	}
+	if s := ep.responseSchema(); s != nil {
+		out = append(out, s)
+	}
+	return out
+}
+
+func (ep endpoint) requestSchema() *Schema {
+	if ep.Op.RequestBody == nil {
+		return nil
+	}
+	return ep.Op.RequestBody.Content["application/json"].Schema
+}
+
+func (ep endpoint) responseSchema() *Schema {
+	for _, code := range []string{"200", "201"} {
+		if r, ok := ep.Op.Responses[code]; ok {
+			return r.Content["application/json"].Schema
+		}
+	}
+	return nil
+}
+
+// claim şemayı ve referans verdiği tüm şemaları namespace'e atar
+func (g *generator) claim(s *Schema, ns string) {
+	if s == nil {
+		return
+	}
+	if s.Ref != "" {
+		name := refName(s.Ref)
+		if _, ok := g.owner[name]; ok {
+			return
+		}
+		g.owner[name] = ns
+		g.claim(g.doc.Components.Schemas[name], ns)
+		return
+	}
+	g.claim(s.Items, ns)
+	g.claim(s.AdditionalProperties, ns)
+	for _, p := range s.Properties {
+		g.claim(p, ns)
+	}
+}
+
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// goType şemanın Go karşılığı
+func (g *generator) goType(s *Schema) string {
+	if s == nil {
+		return "json.RawMessage"
+	}
+	if s.Ref != "" {
+		return refName(s.Ref)
+	}
+	switch s.Type {
+	case "string":
+		return "string"
+	case "boolean":
+		return "bool"
+	case "integer":
+		switch s.Format {
+		case "int8", "int16", "int32", "int64", "uint8", "uint16", "uint32", "uint64":
+			return s.Format
+		}
+		return "int"
+	case "number":
+		if s.Format == "float" {
+			return "float32"
+		}
+		return "float64"
+	case "array":
+		return "[]" + g.goType(s.Items)
+	case "object":
+		if s.AdditionalProperties != nil {
+			return "map[string]" + g.goType(s.AdditionalProperties)
+		}
+	}
+	return "json.RawMessage"
+}
+
+// file bir namespace için Go kaynak dosyası üretir
+func (g *generator) file(ns string, eps []endpoint) ([]byte, error) {
+	var body bytes.Buffer
+
+	for _, ep := range eps {
+		g.writeEndpoint(&body, ep)
+	}
+
+	var names []string
+	for name, owner := range g.owner {
+		if owner == ns {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		g.writeSchema(&body, name, g.doc.Components.Schemas[name])
+	}
+
+	var imports []string
+	src := body.String()
+	if strings.Contains(src, "json.RawMessage") {
+		imports = append(imports, `"encoding/json"`)
+	}
+	if strings.Contains(src, "fmt.") {
+		imports = append(imports, `"fmt"`)
+	}
+	if strings.Contains(src, "url.") {
+		imports = append(imports, `"net/url"`)
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by lcugen from schema/openapi.json. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "package %s\n\n", g.pkg)
+	if len(imports) > 0 {
+		fmt.Fprintf(&out, "import (\n%s\n)\n\n", strings.Join(imports, "\n"))
+	}
+	out.WriteString(src)
+
+	formatted, err := format.Source(out.Bytes())
+	if err != nil {
+		return out.Bytes(), err
+	}
+	return formatted, nil
+}
+
+func (g *generator) writeSchema(w *bytes.Buffer, name string, s *Schema) {
+	if s == nil {
+		return
+	}
+	desc := s.Description
+	if desc == "" {
+		desc = "LCU şeması"
+	}
+
+	if len(s.Enum) > 0 {
+		fmt.Fprintf(w, "// %s %s\ntype %s string\n\n", name, desc, name)
+		fmt.Fprintf(w, "const (\n")
+		for _, v := range s.Enum {
+			fmt.Fprintf(w, "\t%s%s %s = %q\n", name, exportName(v), name, v)
+		}
+		fmt.Fprintf(w, ")\n\n")
+		return
+	}
+
+	if s.Type != "object" || s.Properties == nil {
+		fmt.Fprintf(w, "// %s %s\ntype %s %s\n\n", name, desc, name, g.goType(s))
+		return
+	}
+
+	props := make([]string, 0, len(s.Properties))
+	for p := range s.Properties {
+		props = append(props, p)
+	}
+	sort.Strings(props)
+
+	fmt.Fprintf(w, "// %s %s\ntype %s struct {\n", name, desc, name)
+	for _, p := range props {
+		fmt.Fprintf(w, "\t%s %s `json:\"%s\"`\n", exportName(p), g.goType(s.Properties[p]), p)
+	}
+	fmt.Fprintf(w, "}\n\n")
+}
+
+func (g *generator) writeEndpoint(w *bytes.Buffer, ep endpoint) {
+	op := ep.Op
+
+	var args []string
+	var pathParams, queryParams []Parameter
+	for _, p := range op.Parameters {
+		args = append(args, fmt.Sprintf("%s %s", paramName(p.Name), g.goType(p.Schema)))
+		switch p.In {
+		case "path":
+			pathParams = append(pathParams, p)
+		case "query":
+			queryParams = append(queryParams, p)
+		}
+	}
+	reqSchema := ep.requestSchema()
+	if reqSchema != nil {
+		args = append(args, "body "+g.goType(reqSchema))
+	}
+
+	respSchema := ep.responseSchema()
+	respType := ""
+	if respSchema != nil {
+		respType = g.goType(respSchema)
+	}
+
+	summary := op.Summary
+	if summary == "" {
+		summary = op.Description
+	}
+	fmt.Fprintf(w, "// %s %s %s\n", op.OperationID, ep.Method, ep.Path)
+	if summary != "" {
+		fmt.Fprintf(w, "// %s\n", summary)
+	}
+
+	if respType != "" {
+		fmt.Fprintf(w, "func (c *Client) %s(%s) (%s, error) {\n", op.OperationID, strings.Join(args, ", "), g.resultType(respType))
+	} else {
+		fmt.Fprintf(w, "func (c *Client) %s(%s) error {\n", op.OperationID, strings.Join(args, ", "))
+	}
+
+	// Endpoint yolu
+	path := ep.Path
+	if len(pathParams) > 0 {
+		var vals []string
+		for _, p := range pathParams {
+			path = strings.ReplaceAll(path, "{"+p.Name+"}", "%s")
+			vals = append(vals, fmt.Sprintf("url.PathEscape(fmt.Sprint(%s))", paramName(p.Name)))
+		}
+		fmt.Fprintf(w, "\tendpoint := fmt.Sprintf(%q, %s)\n", path, strings.Join(vals, ", "))
+	} else {
+		fmt.Fprintf(w, "\tendpoint := %q\n", path)
+	}
+	if len(queryParams) > 0 {
+		fmt.Fprintf(w, "\tquery := url.Values{}\n")
+		for _, p := range queryParams {
+			fmt.Fprintf(w, "\tquery.Set(%q, fmt.Sprint(%s))\n", p.Name, paramName(p.Name))
+		}
+		fmt.Fprintf(w, "\tendpoint += \"?\" + query.Encode()\n")
+	}
+
+	bodyArg := "nil"
+	if reqSchema != nil {
+		bodyArg = "body"
+	}
+
+	if respType == "" {
+		fmt.Fprintf(w, "\treturn c.call(%q, endpoint, %s, nil)\n}\n\n", ep.Method, bodyArg)
+		return
+	}
+
+	fmt.Fprintf(w, "\tvar out %s\n", respType)
+	fmt.Fprintf(w, "\tif err := c.call(%q, endpoint, %s, &out); err != nil {\n", ep.Method, bodyArg)
+	if g.isValueType(respType) {
+		fmt.Fprintf(w, "\t\treturn out, err\n\t}\n\treturn out, nil\n}\n\n")
+	} else {
+		fmt.Fprintf(w, "\t\treturn nil, err\n\t}\n\treturn &out, nil\n}\n\n")
+	}
+}
+
+// isValueType yanıt tipinin pointer yerine değer olarak dönülüp dönülmeyeceği.
+// Sadece struct'a dönüşen şemalar pointer olarak döner.
+func (g *generator) isValueType(t string) bool {
+	s, ok := g.doc.Components.Schemas[t]
+	if !ok {
+		return true
+	}
+	return len(s.Enum) > 0 || s.Type != "object" || s.Properties == nil
+}
+
+func (g *generator) resultType(t string) string {
+	if g.isValueType(t) {
+		return t
+	}
+	return "*" + t
+}
+
+// initialisms Go isimlendirme kurallarına göre büyük yazılan kısaltmalar
+var initialisms = map[string]string{
+	"Id": "ID", "Ids": "IDs", "Url": "URL", "Ip": "IP", "Ux": "UX", "Json": "JSON", "Http": "HTTP",
+}
+
+// exportName camelCase/kebab-case bir ismi exported Go ismine çevirir
+func exportName(s string) string {
+	var words []string
+	var cur []rune
+	flush := func() {
+		if len(cur) > 0 {
+			words = append(words, string(cur))
+			cur = nil
+		}
+	}
+	for i, r := range s {
+		switch {
+		case r == '_' || r == '-' || r == ' ' || r == '.':
+			flush()
+			continue
+		case unicode.IsUpper(r) && i > 0 && len(cur) > 0 && !unicode.IsUpper(cur[len(cur)-1]):
+			flush()
+		}
+		cur = append(cur, r)
+	}
+	flush()
+
+	var b strings.Builder
+	for _, w := range words {
+		rs := []rune(w)
+		rs[0] = unicode.ToUpper(rs[0])
+		w = string(rs)
+		if up, ok := initialisms[w]; ok {
+			w = up
+		}
+		b.WriteString(w)
+	}
+	name := b.String()
+	if name == "" || !unicode.IsLetter([]rune(name)[0]) {
+		name = "V" + name
+	}
+	return name
+}
+
+// goKeywords parametre isimlerinde kullanılamayan kelimeler
+var goKeywords = map[string]bool{
+	"break": true, "case": true, "chan": true, "const": true, "continue": true, "default": true,
+	"defer": true, "else": true, "fallthrough": true, "for": true, "func": true, "go": true,
+	"goto": true, "if": true, "import": true, "interface": true, "map": true, "package": true,
+	"range": true, "return": true, "select": true, "struct": true, "switch": true, "type": true,
+	"var": true, "body": true, "endpoint": true, "query": true, "out": true, "c": true,
+}
+
+// paramName parametre ismini yerel değişken ismine çevirir
+func paramName(s string) string {
+	name := exportName(s)
+	rs := []rune(name)
+	// Baştaki kısaltmayı küçült (ID -> id, URLPath -> urlPath)
+	i := 0
+	for i < len(rs) && unicode.IsUpper(rs[i]) {
+		i++
+	}
+	if i > 1 && i < len(rs) {
+		i--
+	}
+	for j := 0; j < i; j++ {
+		rs[j] = unicode.ToLower(rs[j])
+	}
+	name = string(rs)
+	if goKeywords[name] {
+		name += "Param"
+	}
+	return name
+}
@@ -0,0 +1,102 @@
+// lolreport kaydedilmiş bir oyun zaman çizelgesinden Markdown ve HTML maç raporu üretir.
+//
+//	go run ./cmd/lolreport -list
+//	go run ./cmd/lolreport                 # son oyun
+//	go run ./cmd/lolreport -game 1234567890
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/joho/godotenv"
+
+	"lol-helper/internal/appdir"
+	"lol-helper/internal/assets"
+	"lol-helper/internal/i18n"
+	"lol-helper/internal/lol"
+	"lol-helper/internal/report"
+)
+
+func main() {
+	godotenv.Load(".env.local", ".env")
+	i18n.SetLocale(i18n.Parse(os.Getenv("LOL_HELPER_LOCALE")))
+
+	gameID := flag.String("game", "", "Oyun ID'si veya zaman çizelgesi dosyası (boşsa son oyun)")
+	outDir := flag.String("out", "", "Raporların yazılacağı klasör (varsayılan: veri klasörü/reports)")
+	version := flag.String("ddragon", "", "Zaman çizelgesinde yama kayıtlı değilse ikonlar için Data Dragon sürümü")
+	noIcons := flag.Bool("no-icons", false, "Item ikonlarını indirme")
+	list := flag.Bool("list", false, "Kayıtlı oyunları listele")
+	flag.Parse()
+
+	timelineDir, err := appdir.Dir("timelines")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *list {
+		games, err := lol.ListTimelines(timelineDir)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, g := range games {
+			fmt.Printf("%-20s %s  %-12s %5.0f dk  %s\n",
+				g.Header.GameID, g.Header.StartedAt.Format("02.01.2006 15:04"), g.Header.Champion, g.Duration/60, g.Result)
+		}
+		return
+	}
+
+	path, err := resolveTimeline(timelineDir, *gameID)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *outDir == "" {
+		if *outDir, err = appdir.Dir("reports"); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	var icons report.IconSource
+	if !*noIcons {
+		iconDir, err := appdir.Dir("icons")
+		if err != nil {
+			log.Printf("İkon önbelleği kullanılamıyor: %v", err)
+		}
+		service := assets.NewService(iconDir, 0, 0)
+		defer service.Close()
+		icons = report.AssetIcons{Service: service, DefaultPatch: *version}
+	}
+
+	mdPath, htmlPath, err := report.Generate(path, *outDir, icons)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(mdPath)
+	fmt.Println(htmlPath)
+}
+
+// resolveTimeline oyun ID'sini veya dosya yolunu zaman çizelgesi dosyasına çevirir
+func resolveTimeline(dir, game string) (string, error) {
+	if game == "" {
+		games, err := lol.ListTimelines(dir)
+		if err != nil {
+			return "", err
+		}
+		if len(games) == 0 {
+			return "", fmt.Errorf("kayıtlı oyun yok: %s", dir)
+		}
+		return games[0].Path, nil
+	}
+
+	if _, err := os.Stat(game); err == nil {
+		return game, nil
+	}
+	path := lol.NewTimelineRecorder(dir).Path(game)
+	if _, err := os.Stat(path); err != nil {
+		return "", fmt.Errorf("oyun bulunamadı: %s", game)
+	}
+	return path, nil
+}
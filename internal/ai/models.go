@@ -7,7 +7,21 @@ type AnalysisRequest struct {
 	Items       []string `json:"items"`
 	Gold        int      `json:"gold"`
 	EnemyChamps []string `json:"enemy_champions"`
-	GameTime    int      `json:"game_time"` // Saniye cinsinden
+	GameTime    int      `json:"game_time"`   // Saniye cinsinden
+	Spectator   bool     `json:"spectator"`   // Oyun izleniyor, Champion izleyicinin seçtiği oyuncu
+	Composition string   `json:"composition"` // Takım kompozisyonu özeti (hasar, ön hat, CC, arketip)
+	Mode        string   `json:"mode"`        // CLASSIC, ARAM
+
+	// ARAM'a özel alanlar
+	Bench            []string `json:"bench,omitempty"`             // Şampiyon seçimindeki yedek kulübesi
+	RerollsRemaining int      `json:"rerolls_remaining,omitempty"` // Kalan yeniden seçim hakkı
+	ARAMBalance      string   `json:"aram_balance,omitempty"`      // Oyundaki şampiyonların ARAM denge ayarları
+
+	// Arena'ya özel alanlar
+	ArenaRound     int      `json:"arena_round,omitempty"`
+	ArenaPartner   string   `json:"arena_partner,omitempty"`   // İkili arkadaşının şampiyonu
+	ArenaOpponents []string `json:"arena_opponents,omitempty"` // Elenmemiş rakip ikililer ("Ahri + Leona")
+	Augments       []string `json:"augments,omitempty"`        // Seçilmiş augmentler
 }
 
 // AnalysisResponse AI analiz cevabı
@@ -16,3 +30,25 @@ type AnalysisResponse struct {
 	NextItems  []string `json:"next_items"`
 	Strategy   string   `json:"strategy"`
 }
+
+// SkillOrderResponse AI yetenek sırası önerisi
+type SkillOrderResponse struct {
+	MaxOrder []string `json:"max_order"` // Örn. ["Q", "E", "W"]
+}
+
+// AugmentRequest Arena'da sunulan augmentlerden seçim isteği
+type AugmentRequest struct {
+	Champion  string   `json:"champion"`
+	Partner   string   `json:"partner"`
+	Options   []string `json:"options"`
+	Augments  []string `json:"augments"` // Daha önce seçilmiş augmentler
+	Items     []string `json:"items"`
+	Opponents []string `json:"opponents"`
+	Round     int      `json:"round"`
+}
+
+// AugmentResponse AI augment seçimi önerisi
+type AugmentResponse struct {
+	Pick   string `json:"pick"`
+	Reason string `json:"reason"`
+}
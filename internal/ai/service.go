@@ -13,8 +13,9 @@ import (
 
 // Service AI servisi
 type Service struct {
-	client *genai.Client
-	model  *genai.GenerativeModel
+	client   *genai.Client
+	model    *genai.GenerativeModel
+	language string // Cevap metinlerinin dili ("Turkish"); boşsa model serbest
 }
 
 // NewService yeni bir AI servisi oluşturur
@@ -44,11 +45,63 @@ func NewService() (*Service, error) {
 	}, nil
 }
 
+// SetLanguage cevaplardaki açıklamaların ve item isimlerinin yazılacağı dili ayarlar
+func (s *Service) SetLanguage(language string) {
+	s.language = language
+}
+
+// languageInstruction istemin sonuna eklenen dil talimatı; item isimleri arayüzün
+// Data Dragon diliyle aynı olmalı ki ID'lere çevrilebilsin
+func (s *Service) languageInstruction(fields string) string {
+	if s.language == "" {
+		return ""
+	}
+	return fmt.Sprintf(`
+		Write %s in %s. Use the official %s League of Legends names for items, champions and augments.
+	`, fields, s.language, s.language)
+}
+
 // AnalyzeGame oyun durumunu analiz eder ve önerilerde bulunur
 func (s *Service) AnalyzeGame(req AnalysisRequest) (*AnalysisResponse, error) {
 	ctx := context.Background()
 
-	prompt := fmt.Sprintf(`
+	var prompt string
+	switch req.Mode {
+	case "ARAM":
+		prompt = aramPrompt(req)
+	case "CHERRY":
+		prompt = arenaPrompt(req)
+	default:
+		prompt = classicPrompt(req)
+	}
+
+	// İzlenen oyunda altın görünmez; koç seçilen oyuncunun gözünden konuşur
+	if req.Spectator {
+		prompt += fmt.Sprintf(`
+		This game is being spectated. Coach it from the perspective of the %s player; their current gold is unknown, so base item advice on their current items.
+	`, req.Champion)
+	}
+
+	if req.Composition != "" {
+		prompt += fmt.Sprintf(`
+		Team compositions:
+		%s
+		Take both teams' damage profile, frontline, crowd control and win conditions into account.
+	`, req.Composition)
+	}
+
+	prompt += s.languageInstruction(`"suggestion" and "strategy"`)
+
+	var analysisResp AnalysisResponse
+	if err := s.generateJSON(ctx, prompt, &analysisResp); err != nil {
+		return nil, err
+	}
+	return &analysisResp, nil
+}
+
+// classicPrompt Sihirdar Vadisi için analiz istemi
+func classicPrompt(req AnalysisRequest) string {
+	return fmt.Sprintf(`
 		You are a League of Legends expert coach. Analyze the current game state and provide advice.
 		
 		Current State:
@@ -68,37 +121,158 @@ func (s *Service) AnalyzeGame(req AnalysisRequest) (*AnalysisResponse, error) {
 		
 		Focus on the next best item to buy with the available gold and the best strategy against the enemy team composition.
 	`, req.GamePhase, req.Champion, strings.Join(req.Items, ", "), req.Gold, strings.Join(req.EnemyChamps, ", "), req.GameTime)
+}
+
+// aramPrompt Sonsuz Uçurum için analiz istemi. Koridor, ejderha ve geri dönüş
+// yoktur; item önerileri tek seferde alışveriş ve sürekli takım savaşına göre yapılır.
+func aramPrompt(req AnalysisRequest) string {
+	prompt := fmt.Sprintf(`
+		You are a League of Legends expert coach for ARAM (Howling Abyss, single lane, no recall except on death).
+		
+		Current State:
+		- Phase: %s
+		- My Champion: %s
+		- Current Items: %s
+		- Gold Available: %d
+		- Enemy Champions: %s
+		- Game Time: %d seconds
+
+		Provide a JSON response with the following structure:
+		{
+			"suggestion": "General ARAM advice (poke vs all-in, health relic timing, when to engage)",
+			"next_items": ["Item 1", "Item 2"],
+			"strategy": "Specific teamfight strategy for this ARAM matchup"
+		}
+		
+		Items can only be bought after dying or at the start, so recommend complete purchases that fit the continuous teamfighting of ARAM. Do not mention lanes, jungle, dragons or barons.
+	`, req.GamePhase, req.Champion, strings.Join(req.Items, ", "), req.Gold, strings.Join(req.EnemyChamps, ", "), req.GameTime)
+
+	if req.ARAMBalance != "" {
+		prompt += fmt.Sprintf(`
+		ARAM balance adjustments in this game: %s
+		Factor these adjustments into matchup and item advice.
+	`, req.ARAMBalance)
+	}
+	if req.GamePhase == "ChampSelect" && (len(req.Bench) > 0 || req.RerollsRemaining > 0) {
+		prompt += fmt.Sprintf(`
+		We are in champion select. Bench champions available to swap: %s. Rerolls remaining: %d.
+		In "suggestion", say whether to keep the current champion, swap with a specific bench champion or reroll, considering the team composition.
+	`, strings.Join(req.Bench, ", "), req.RerollsRemaining)
+	}
+	return prompt
+}
+
+// arenaPrompt Arena için analiz istemi. Koridor yoktur; tavsiye ikili uyumu,
+// augmentler ve kalan rakip ikililere göre tur tur verilir.
+func arenaPrompt(req AnalysisRequest) string {
+	return fmt.Sprintf(`
+		You are a League of Legends expert coach for Arena (2v2v2v2 rounds, no lanes, items and augments between rounds).
+		
+		Current State:
+		- Round: %d
+		- My Champion: %s
+		- Duo Partner: %s
+		- My Augments: %s
+		- Current Items: %s
+		- Gold Available: %d
+		- Remaining Opponent Duos: %s
+
+		Provide a JSON response with the following structure:
+		{
+			"suggestion": "How my duo should play the next rounds together (combo, positioning, who to focus)",
+			"next_items": ["Item 1", "Item 2"],
+			"strategy": "Specific plan against the most threatening remaining duo"
+		}
+		
+		Reason about duo synergy and augment synergies instead of lanes. Do not mention lanes, minions, towers, dragons or barons.
+	`, req.ArenaRound, req.Champion, req.ArenaPartner, strings.Join(req.Augments, ", "), strings.Join(req.Items, ", "), req.Gold, strings.Join(req.ArenaOpponents, "; "))
+}
+
+// SuggestAugment Arena'da sunulan augmentlerden en uygununu seçer
+func (s *Service) SuggestAugment(req AugmentRequest) (*AugmentResponse, error) {
+	ctx := context.Background()
+
+	prompt := fmt.Sprintf(`
+		You are a League of Legends Arena expert. Pick the best augment for my champion from the offered options.
+
+		- Round: %d
+		- My Champion: %s
+		- Duo Partner: %s
+		- Current Augments: %s
+		- Current Items: %s
+		- Remaining Opponent Duos: %s
+		- Offered Augments: %s
+
+		Provide a JSON response with the following structure:
+		{
+			"pick": "Exact name of one offered augment",
+			"reason": "One or two sentences on why it fits my champion, my partner and current augments"
+		}
+	`, req.Round, req.Champion, req.Partner, strings.Join(req.Augments, ", "), strings.Join(req.Items, ", "), strings.Join(req.Opponents, "; "), strings.Join(req.Options, ", "))
+
+	prompt += s.languageInstruction(`"reason"`)
 
+	var resp AugmentResponse
+	if err := s.generateJSON(ctx, prompt, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SuggestSkillOrder şampiyon için Q/W/E maksimize sırası önerir
+func (s *Service) SuggestSkillOrder(champion string, enemyChamps []string) ([]string, error) {
+	ctx := context.Background()
+
+	prompt := fmt.Sprintf(`
+		You are a League of Legends expert coach. Recommend the skill max order for the champion below.
+
+		- My Champion: %s
+		- Enemy Champions: %s
+
+		Provide a JSON response with the following structure:
+		{
+			"max_order": ["Q", "E", "W"]
+		}
+
+		"max_order" must contain each of Q, W and E exactly once, in the order they should be maxed. R is always leveled when available and must not be included.
+	`, champion, strings.Join(enemyChamps, ", "))
+
+	var orderResp SkillOrderResponse
+	if err := s.generateJSON(ctx, prompt, &orderResp); err != nil {
+		return nil, err
+	}
+	return orderResp.MaxOrder, nil
+}
+
+// generateJSON prompt'u modele gönderir ve JSON cevabı out'a çözer
+func (s *Service) generateJSON(ctx context.Context, prompt string, out any) error {
 	resp, err := s.model.GenerateContent(ctx, genai.Text(prompt))
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate content: %w", err)
+		return fmt.Errorf("failed to generate content: %w", err)
 	}
 
 	if len(resp.Candidates) == 0 || len(resp.Candidates[0].Content.Parts) == 0 {
-		return nil, fmt.Errorf("no response from AI")
+		return fmt.Errorf("no response from AI")
 	}
 
-	// JSON yanıtını parse et
-	var analysisResp AnalysisResponse
-
 	// Part'ı string'e çevirip JSON unmarshal yap
 	for _, part := range resp.Candidates[0].Content.Parts {
 		if txt, ok := part.(genai.Text); ok {
-			if err := json.Unmarshal([]byte(txt), &analysisResp); err != nil {
+			if err := json.Unmarshal([]byte(txt), out); err != nil {
 				// JSON bloğunu temizlemeyi dene (markdown ```json ... ``` varsa)
 				cleanJSON := strings.TrimPrefix(string(txt), "```json")
 				cleanJSON = strings.TrimPrefix(cleanJSON, "```")
 				cleanJSON = strings.TrimSuffix(cleanJSON, "```")
 
-				if err2 := json.Unmarshal([]byte(cleanJSON), &analysisResp); err2 != nil {
-					return nil, fmt.Errorf("failed to parse JSON response: %w", err)
+				if err2 := json.Unmarshal([]byte(cleanJSON), out); err2 != nil {
+					return fmt.Errorf("failed to parse JSON response: %w", err)
 				}
 			}
 			break
 		}
 	}
 
-	return &analysisResp, nil
+	return nil
 }
 
 // Close servisi kapatır
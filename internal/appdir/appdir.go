@@ -0,0 +1,59 @@
+package appdir
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Dir uygulamanın kalıcı veri klasörü altında (gerekirse oluşturarak) bir alt klasör döner.
+// Varsayılan konum işletim sisteminin kullanıcı yapılandırma klasöründeki "lol-helper"
+// klasörüdür; LOL_HELPER_DATA_DIR ortam değişkeniyle değiştirilebilir.
+func Dir(sub ...string) (string, error) {
+	base := os.Getenv("LOL_HELPER_DATA_DIR")
+	if base == "" {
+		configDir, err := os.UserConfigDir()
+		if err != nil {
+			return "", fmt.Errorf("veri klasörü bulunamadı: %w", err)
+		}
+		base = filepath.Join(configDir, "lol-helper")
+	}
+
+	dir := filepath.Join(append([]string{base}, sub...)...)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("veri klasörü oluşturulamadı: %w", err)
+	}
+	return dir, nil
+}
+
+// WriteFile dosyayı aynı klasörde geçici bir dosyaya yazıp yerine taşır; yarım kalan
+// yazma (çökme, dolu disk) var olan dosyayı bozmaz. Gerekirse klasörü oluşturur.
+func WriteFile(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	// CreateTemp 0600 ile oluşturur; önbellek dosyaları diğerleri gibi okunabilir kalsın
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
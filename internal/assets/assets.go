@@ -0,0 +1,281 @@
+// Package assets Data Dragon ikonlarını arka planda indirir, diske ve belleğe önbellekler.
+// İstekler sınırlı sayıda işçiyle işlenir; çağıran hiçbir zaman indirmeyi beklemez.
+package assets
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"lol-helper/internal/appdir"
+)
+
+const (
+	ddragonURL = "https://ddragon.leagueoflegends.com"
+
+	// DefaultWorkers aynı anda yapılan en fazla indirme sayısı
+	DefaultWorkers = 4
+	// DefaultCapacity bellekte tutulan en fazla ikon sayısı
+	DefaultCapacity = 512
+
+	// queueSize işçileri bekleyen istek kuyruğunun boyu
+	queueSize = 256
+	// failureTTL indirilemeyen bir ikonun yeniden denenmeden önce beklenen süre; olmayan
+	// ikonlar (ör. yeni item) her satır yenilemesinde tekrar istenmesin
+	failureTTL = 5 * time.Minute
+)
+
+// Kind ikon türü; disk önbelleğinde yama altındaki klasör adıdır
+type Kind string
+
+const (
+	KindItem        Kind = "item"
+	KindChampion    Kind = "champion"
+	KindSpell       Kind = "spell"
+	KindRune        Kind = "rune" // Rün ikonları sürümsüzdür; Patch yok sayılır
+	KindProfileIcon Kind = "profileicon"
+)
+
+// Key bir ikonu tanımlar. Name türe göre item ID'si ("3031"), şampiyon anahtarı
+// ("MonkeyKing"), büyü anahtarı ("SummonerFlash"), rün ikon yolu
+// ("perk-images/Styles/...png") veya profil ikonu numarasıdır.
+type Key struct {
+	Kind  Kind
+	Patch string
+	Name  string
+}
+
+// versionless türün ikonlarının yamadan bağımsız olup olmadığı
+func (k Key) versionless() bool {
+	return k.Kind == KindRune
+}
+
+// url ikonun Data Dragon adresi
+func (k Key) url(base string) string {
+	if k.versionless() {
+		return fmt.Sprintf("%s/cdn/img/%s", base, k.Name)
+	}
+	return fmt.Sprintf("%s/cdn/%s/img/%s/%s.png", base, k.Patch, k.Kind, k.Name)
+}
+
+// cachePath ikonun disk önbelleğindeki göreli yolu: <yama>/<tür>/<ad>.png,
+// sürümsüz ikonlarda <tür>/<yol>
+func (k Key) cachePath() (string, error) {
+	name := path.Clean("/" + k.Name)[1:]
+	if name == "" || strings.Contains(k.Name, "..") {
+		return "", fmt.Errorf("geçersiz ikon adı: %q", k.Name)
+	}
+	if k.versionless() {
+		return filepath.Join(string(k.Kind), filepath.FromSlash(name)), nil
+	}
+	if k.Patch == "" || strings.ContainsAny(k.Patch, `/\`) {
+		return "", fmt.Errorf("geçersiz yama: %q", k.Patch)
+	}
+	return filepath.Join(k.Patch, string(k.Kind), filepath.FromSlash(name)+".png"), nil
+}
+
+// Service ikon indirme servisi
+type Service struct {
+	dir     string // Boşsa disk önbelleği kullanılmaz
+	baseURL string
+	client  *http.Client
+	cache   *lru
+
+	queue chan Key
+	stop  chan struct{}
+	wg    sync.WaitGroup
+
+	mu      sync.Mutex
+	pending map[Key][]func([]byte, error) // İndirilmekte olan ikonları bekleyenler
+	failed  map[Key]failure               // Son indirmesi başarısız olan ikonlar
+}
+
+// failure başarısız bir indirmenin hatası ve zamanı
+type failure struct {
+	err error
+	at  time.Time
+}
+
+// NewService dir altında önbellekleyen ve workers işçiyle indiren bir servis başlatır.
+// workers veya capacity sıfırsa varsayılanlar kullanılır.
+func NewService(dir string, workers, capacity int) *Service {
+	if workers <= 0 {
+		workers = DefaultWorkers
+	}
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+	s := &Service{
+		dir:     dir,
+		baseURL: ddragonURL,
+		client:  &http.Client{Timeout: 15 * time.Second},
+		cache:   newLRU(capacity),
+		queue:   make(chan Key, queueSize),
+		stop:    make(chan struct{}),
+		pending: make(map[Key][]func([]byte, error)),
+		failed:  make(map[Key]failure),
+	}
+	for i := 0; i < workers; i++ {
+		s.wg.Add(1)
+		go s.worker()
+	}
+	return s
+}
+
+// Get ikon bellekteyse verisini döner; indirme başlatmaz
+func (s *Service) Get(key Key) ([]byte, bool) {
+	return s.cache.get(key)
+}
+
+// Load ikonu arka planda yükler ve done'ı işçi goroutine'inden çağırır. İkon
+// bellekteyse done hemen çağrılır. Aynı ikon için gelen istekler tek indirmede
+// birleştirilir. Son failureTTL içinde indirilemeyen ikonlar için done aynı hatayla
+// hemen çağrılır. Load hiçbir zaman bloklamaz.
+func (s *Service) Load(key Key, done func(data []byte, err error)) {
+	if data, ok := s.cache.get(key); ok {
+		done(data, nil)
+		return
+	}
+	select {
+	case <-s.stop:
+		done(nil, errClosed)
+		return
+	default:
+	}
+
+	s.mu.Lock()
+	if f, ok := s.failed[key]; ok {
+		if time.Since(f.at) < failureTTL {
+			s.mu.Unlock()
+			done(nil, f.err)
+			return
+		}
+		delete(s.failed, key)
+	}
+	waiters, inFlight := s.pending[key]
+	s.pending[key] = append(waiters, done)
+	s.mu.Unlock()
+	if inFlight {
+		return
+	}
+
+	select {
+	case s.queue <- key:
+	case <-s.stop:
+		s.finish(key, nil, errClosed)
+	default:
+		// Kuyruk doluysa sıra gelene kadar ayrı bir goroutine'de bekle; indirme yine işçilerde yapılır
+		go func() {
+			select {
+			case s.queue <- key:
+			case <-s.stop:
+				s.finish(key, nil, errClosed)
+			}
+		}()
+	}
+}
+
+var errClosed = errors.New("ikon servisi kapatıldı")
+
+// Fetch ikonu yükler ve sonucu bekler. Arayüz dışındaki kullanım (ör. rapor üretimi)
+// içindir; indirme yine işçilerde yapılır ve aynı önbellekleri kullanır.
+func (s *Service) Fetch(key Key) ([]byte, error) {
+	type result struct {
+		data []byte
+		err  error
+	}
+	ch := make(chan result, 1)
+	s.Load(key, func(data []byte, err error) { ch <- result{data, err} })
+	r := <-ch
+	return r.data, r.err
+}
+
+// Close işçileri durdurur; bekleyen istekler hata ile sonlanır
+func (s *Service) Close() {
+	close(s.stop)
+	s.wg.Wait()
+
+	s.mu.Lock()
+	keys := make([]Key, 0, len(s.pending))
+	for key := range s.pending {
+		keys = append(keys, key)
+	}
+	s.mu.Unlock()
+	for _, key := range keys {
+		s.finish(key, nil, errClosed)
+	}
+}
+
+func (s *Service) worker() {
+	defer s.wg.Done()
+	for {
+		select {
+		case <-s.stop:
+			return
+		case key := <-s.queue:
+			data, err := s.fetch(key)
+			if err == nil {
+				s.cache.put(key, data)
+			} else {
+				s.mu.Lock()
+				s.failed[key] = failure{err: err, at: time.Now()}
+				s.mu.Unlock()
+			}
+			s.finish(key, data, err)
+		}
+	}
+}
+
+// finish ikonu bekleyen herkese sonucu iletir
+func (s *Service) finish(key Key, data []byte, err error) {
+	s.mu.Lock()
+	waiters := s.pending[key]
+	delete(s.pending, key)
+	s.mu.Unlock()
+	for _, done := range waiters {
+		done(data, err)
+	}
+}
+
+// fetch ikonu önce diskten, yoksa Data Dragon'dan okur ve diske yazar
+func (s *Service) fetch(key Key) ([]byte, error) {
+	rel, err := key.cachePath()
+	if err != nil {
+		return nil, err
+	}
+	if s.dir != "" {
+		if data, err := os.ReadFile(filepath.Join(s.dir, rel)); err == nil {
+			return data, nil
+		}
+	}
+
+	url := key.url(s.baseURL)
+	resp, err := s.client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", url, resp.Status)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	s.writeCache(rel, data)
+	return data, nil
+}
+
+// writeCache ikonu disk önbelleğine yazar. Önbellek yazılamazsa ikon yine de bellekte kullanılır.
+func (s *Service) writeCache(rel string, data []byte) {
+	if s.dir != "" {
+		appdir.WriteFile(filepath.Join(s.dir, rel), data)
+	}
+}
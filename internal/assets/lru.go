@@ -0,0 +1,59 @@
+package assets
+
+import (
+	"container/list"
+	"sync"
+)
+
+// lru eşzamanlı kullanıma güvenli, eleman sayısıyla sınırlı en-az-yakın-zamanda-kullanılan önbellek
+type lru struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List // Önde en son kullanılan
+	entries  map[Key]*list.Element
+}
+
+type lruEntry struct {
+	key  Key
+	data []byte
+}
+
+func newLRU(capacity int) *lru {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &lru{
+		capacity: capacity,
+		order:    list.New(),
+		entries:  make(map[Key]*list.Element, capacity),
+	}
+}
+
+// get anahtarın verisini döner ve anahtarı en son kullanılan yapar
+func (c *lru) get(key Key) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return el.Value.(*lruEntry).data, true
+}
+
+// put veriyi ekler; kapasite aşılırsa en uzun süredir kullanılmayan silinir
+func (c *lru) put(key Key, data []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		el.Value.(*lruEntry).data = data
+		c.order.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&lruEntry{key: key, data: data})
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry).key)
+	}
+}
@@ -0,0 +1,82 @@
+package gui
+
+import (
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+
+	"lol-helper/internal/i18n"
+	"lol-helper/internal/lol"
+	"lol-helper/internal/staticdata"
+)
+
+// benchBar ARAM şampiyon seçiminde yedek kulübesini, yeniden seçim hakkını ve
+// kulübedeki şampiyonların ARAM denge ayarlarını gösterir
+type benchBar struct {
+	container fyne.CanvasObject
+	label     *widget.Label
+}
+
+// newBenchBar yeni bir yedek kulübesi çubuğu oluşturur
+func newBenchBar() *benchBar {
+	b := &benchBar{label: widget.NewLabel("")}
+	b.label.Wrapping = fyne.TextWrapWord
+	b.container = b.label
+	b.container.Hide()
+	return b
+}
+
+// Update sadece ARAM şampiyon seçiminde çubuğu gösterir; data yüklü yamanın verisidir
+func (b *benchBar) Update(state *lol.GameState, data *staticdata.GameData) {
+	if state.Mode != lol.ModeARAM || state.Phase != "ChampSelect" || !state.Bench.Enabled {
+		b.container.Hide()
+		return
+	}
+	b.label.SetText(formatBench(data, state.Bench))
+	b.container.Show()
+}
+
+// formatBench "Yedek Kulübesi: Sona (hasar -10%), Garen | Yeniden seçim: 1" gibi bir metin üretir
+func formatBench(data *staticdata.GameData, bench lol.ChampSelectBench) string {
+	names := make([]string, 0, len(bench.Champions))
+	for _, name := range bench.Champions {
+		if data == nil {
+			names = append(names, name)
+			continue
+		}
+		if ch, ok := data.ChampionByName(name); ok && ch.ARAM != nil {
+			name += " (" + formatARAMBalance(*ch.ARAM) + ")"
+		}
+		names = append(names, name)
+	}
+
+	text := i18n.T("aram.bench", "-")
+	if len(names) > 0 {
+		text = i18n.T("aram.bench", strings.Join(names, ", "))
+	}
+	if bench.AllowRerolling {
+		text += " | " + i18n.T("aram.rerolls", bench.RerollsRemaining)
+	}
+	return text
+}
+
+// formatARAMBalance değişen denge çarpanlarını "hasar -10%, iyileştirme -20%" biçiminde döner
+func formatARAMBalance(b staticdata.ARAMBalance) string {
+	var parts []string
+	for _, m := range []struct {
+		key   string
+		value float64
+	}{
+		{"aram.damageDealt", b.DamageDealt},
+		{"aram.damageTaken", b.DamageTaken},
+		{"aram.healing", b.Healing},
+		{"aram.shielding", b.Shielding},
+	} {
+		if m.value != 0 && m.value != 1 {
+			parts = append(parts, fmt.Sprintf("%s %+.0f%%", i18n.T(m.key), (m.value-1)*100))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,188 @@
+package gui
+
+import (
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"lol-helper/internal/i18n"
+	"lol-helper/internal/lcu"
+	"lol-helper/internal/lol"
+)
+
+// arenaAugmentOptions Arena'da her seçimde sunulan augment sayısı
+const arenaAugmentOptions = 3
+
+// arenaBar Arena'da tur, ikili ve seçilen augmentleri gösteren çubuk
+type arenaBar struct {
+	container fyne.CanvasObject
+	label     *widget.Label
+	button    *widget.Button
+}
+
+// newArenaBar yeni bir Arena çubuğu oluşturur; onPick "Augment Seçimi" düğmesine basılınca çağrılır
+func newArenaBar(onPick func()) *arenaBar {
+	b := &arenaBar{label: widget.NewLabel("")}
+	b.label.Wrapping = fyne.TextWrapWord
+	b.button = widget.NewButton(i18n.T("arena.pick"), onPick)
+	b.container = container.NewBorder(nil, nil, nil, b.button, b.label)
+	b.container.Hide()
+	return b
+}
+
+// Update sadece Arena'da çubuğu gösterir; izleyicide augment seçimi kapalıdır
+func (b *arenaBar) Update(state *lol.GameState) {
+	if state.Arena == nil {
+		b.container.Hide()
+		return
+	}
+	if state.Spectator {
+		b.button.Disable()
+	} else {
+		b.button.Enable()
+	}
+	b.label.SetText(formatArenaState(state.Arena))
+	b.container.Show()
+}
+
+// formatArenaState "Tur 3 | İkili: Ahri + Leona | Augmentler: X, Y | Kalan rakip: 2" gibi bir metin üretir
+func formatArenaState(arena *lol.ArenaState) string {
+	parts := []string{i18n.T("arena.round", arena.Round)}
+	for _, duo := range arena.Duos {
+		if duo.ID == arena.LocalDuo {
+			parts = append(parts, i18n.T("arena.duo", duo.Label()))
+		}
+	}
+	if len(arena.Augments) > 0 {
+		parts = append(parts, i18n.T("arena.augments", strings.Join(arena.Augments, ", ")))
+	}
+	if arena.LocalDuo != 0 {
+		parts = append(parts, i18n.T("arena.opponentsLeft", len(arena.OpponentLabels())))
+	}
+	return strings.Join(parts, " | ")
+}
+
+// arenaLayoutKey skor tablosunun yeniden kurulmasını gerektiren Arena alanları
+func arenaLayoutKey(arena *lol.ArenaState) string {
+	if arena == nil {
+		return ""
+	}
+	key := fmt.Sprintf("%d:", arena.LocalDuo)
+	for _, duo := range arena.Duos {
+		key += fmt.Sprintf("%d=%t,", duo.ID, duo.Eliminated)
+	}
+	return key
+}
+
+// buildArenaLists oyuncuları ikililer halinde iki sütuna yerleştirir (tek numaralı
+// ikililer solda, çiftler sağda). Çağıran rowMu ve spellButtons'ı sıfırlamış olmalı.
+func (mw *MainWindow) buildArenaLists(players []lcu.LivePlayer, arena *lol.ArenaState, spectator bool) {
+	byName := make(map[string]lcu.LivePlayer, len(players))
+	for _, p := range players {
+		byName[p.SummonerName] = p
+	}
+
+	columns := [2][]fyne.CanvasObject{}
+	for i, duo := range arena.Duos {
+		title := i18n.T("arena.duoTitle", duo.ID, duo.Label())
+		if duo.ID == arena.LocalDuo {
+			title += " " + i18n.T("arena.us")
+		}
+		if duo.Eliminated {
+			title += " — " + i18n.T("arena.eliminated")
+		}
+		col := i % 2
+		columns[col] = append(columns[col],
+			widget.NewLabelWithStyle(title, fyne.TextAlignCenter, fyne.TextStyle{Bold: true}),
+			mw.createTableHeader(),
+		)
+		for _, name := range duo.Players {
+			p, ok := byName[name]
+			if !ok {
+				continue
+			}
+			// Tarafsız izleyicide herkes rakip sayılır ki büyü sayaçları açık olsun
+			enemy := duo.ID != arena.LocalDuo || (spectator && arena.LocalDuo == 0)
+			columns[col] = append(columns[col], mw.createPlayerRow(p, enemy))
+		}
+	}
+
+	mw.teamOrderContainer.Objects = columns[0]
+	mw.teamChaosContainer.Objects = columns[1]
+	mw.teamOrderContainer.Refresh()
+	mw.teamChaosContainer.Refresh()
+}
+
+// showAugmentDialog sunulan augmentleri girdirir, AI'dan öneri alır ve seçileni kaydeder
+func (mw *MainWindow) showAugmentDialog() {
+	if mw.service == nil {
+		return
+	}
+
+	// Augment listesi alınamazsa kullanıcı isimleri elle yazabilir
+	names, err := mw.service.ArenaAugments()
+	if err != nil {
+		fyne.LogError("Augment listesi", err)
+	}
+
+	entries := make([]*widget.SelectEntry, arenaAugmentOptions)
+	items := make([]*widget.FormItem, 0, arenaAugmentOptions)
+	for i := range entries {
+		entries[i] = widget.NewSelectEntry(names)
+		entries[i].SetPlaceHolder(i18n.T("arena.augmentName"))
+		items = append(items, widget.NewFormItem(i18n.T("arena.option", i+1), entries[i]))
+	}
+
+	dialog.ShowForm(i18n.T("arena.pick"), i18n.T("arena.ask"), i18n.T("common.cancel"), items, func(ok bool) {
+		if !ok {
+			return
+		}
+		var options []string
+		for _, e := range entries {
+			if text := strings.TrimSpace(e.Text); text != "" {
+				options = append(options, text)
+			}
+		}
+		if len(options) == 0 {
+			return
+		}
+		go mw.suggestAugment(options)
+	}, mw.window)
+}
+
+// suggestAugment AI önerisini alır ve seçimi onaylatır (AI çağrısı sürebileceği için arka planda)
+func (mw *MainWindow) suggestAugment(options []string) {
+	mw.statusLabel.SetText(i18n.T("status.augmentWaiting"))
+	resp, err := mw.service.SuggestAugment(options)
+	mw.statusLabel.SetText(i18n.T("status.connected"))
+	if err != nil {
+		dialog.ShowError(err, mw.window)
+		return
+	}
+
+	sel := widget.NewSelect(options, nil)
+	sel.SetSelected(options[0])
+	for _, o := range options {
+		if strings.EqualFold(o, resp.Pick) {
+			sel.SetSelected(o)
+		}
+	}
+	reason := widget.NewLabel(i18n.T("arena.suggestion", resp.Pick, resp.Reason))
+	reason.Wrapping = fyne.TextWrapWord
+
+	items := []*widget.FormItem{
+		widget.NewFormItem("", reason),
+		widget.NewFormItem(i18n.T("arena.selected"), sel),
+	}
+	d := dialog.NewForm(i18n.T("arena.suggestionTitle"), i18n.T("common.save"), i18n.T("common.close"), items, func(ok bool) {
+		if ok && sel.Selected != "" {
+			mw.service.AddAugment(sel.Selected)
+		}
+	}, mw.window)
+	d.Resize(fyne.NewSize(420, 260))
+	d.Show()
+}
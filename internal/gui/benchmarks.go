@@ -0,0 +1,90 @@
+package gui
+
+import (
+	"fmt"
+	"image/color"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+
+	"lol-helper/internal/i18n"
+	"lol-helper/internal/lol"
+)
+
+// gradeColors hedef derecesine göre satır arka plan renkleri
+var gradeColors = map[lol.BenchmarkGrade]color.Color{
+	lol.GradeNone:   color.Transparent,
+	lol.GradeGood:   color.RGBA{R: 60, G: 160, B: 80, A: 50},
+	lol.GradeClose:  color.RGBA{R: 200, G: 180, B: 60, A: 50},
+	lol.GradeBehind: color.RGBA{R: 190, G: 60, B: 60, A: 50},
+}
+
+// newBenchmarkLabel satırdaki hedef farkı etiketini oluşturur ve oyuncu adına kaydeder
+func (mw *MainWindow) newBenchmarkLabel(name string) *widget.Label {
+	label := widget.NewLabel("-")
+	label.Truncation = fyne.TextTruncateEllipsis
+	mw.rowMu.Lock()
+	mw.benchLabels[name] = label
+	mw.rowMu.Unlock()
+	return label
+}
+
+// updateBenchmarks satır renklerini ve hedef farkı etiketlerini günceller
+func (mw *MainWindow) updateBenchmarks(results map[string]lol.BenchmarkResult) {
+	mw.rowMu.Lock()
+	defer mw.rowMu.Unlock()
+
+	for name, bg := range mw.rowBackgrounds {
+		r, ok := results[name]
+		base := gradeColors[lol.GradeNone]
+		text := "-"
+		if ok {
+			base = gradeColors[r.Grade]
+			text = formatBenchmarkGap(r)
+		}
+
+		// Vurgu sürerken rengi ezme, vurgu bitince taban renge dönülür
+		mw.rowBaseColors[name] = base
+		if bg.FillColor != rowHighlightColor && bg.FillColor != base {
+			bg.FillColor = base
+			bg.Refresh()
+		}
+		if label, ok := mw.benchLabels[name]; ok && label.Text != text {
+			label.SetText(text)
+		}
+	}
+}
+
+// rowBaseColor oyuncu satırının vurgu dışındaki rengi
+func (mw *MainWindow) rowBaseColor(name string) color.Color {
+	mw.rowMu.Lock()
+	defer mw.rowMu.Unlock()
+	if c, ok := mw.rowBaseColors[name]; ok {
+		return c
+	}
+	return color.Transparent
+}
+
+// formatBenchmarkGap satırda gösterilen kısa fark: "CS -0.8 G +0.1 KP +5%"
+func formatBenchmarkGap(r lol.BenchmarkResult) string {
+	return fmt.Sprintf("CS %+.1f G %+.1f KP %+.0f%%",
+		r.CSPerMin-r.Target.CSPerMin,
+		r.VisionPerMin-r.Target.VisionPerMin,
+		(r.KillParticipation-r.Target.KillParticipation)*100)
+}
+
+// formatBenchmarkDetail oyuncu detayındaki hedef bölümü
+func formatBenchmarkDetail(r lol.BenchmarkResult, tier string) string {
+	lines := []string{
+		i18n.T("benchmark.tier", tier, positionName(r.Position)),
+		i18n.T("benchmark.cs", r.CSPerMin, r.Target.CSPerMin),
+		i18n.T("benchmark.vision", r.VisionPerMin, r.Target.VisionPerMin),
+		i18n.T("benchmark.kp", r.KillParticipation*100, r.Target.KillParticipation*100),
+	}
+	for _, c := range r.Checkpoints {
+		lines = append(lines, i18n.T("benchmark.checkpoint",
+			c.Minute, c.CSGap, c.VisionGap, c.KPGap*100))
+	}
+	return strings.Join(lines, "\n")
+}
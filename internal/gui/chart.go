@@ -0,0 +1,180 @@
+package gui
+
+import (
+	"image/color"
+	"math"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/widget"
+)
+
+// chartPoint grafikteki tek nokta
+type chartPoint struct {
+	X, Y float64
+}
+
+// chartSeries grafikteki tek çizgi
+type chartSeries struct {
+	Name   string
+	Color  color.Color
+	Points []chartPoint
+}
+
+// lineChart canvas çizgileriyle çizilen basit çizgi grafiği (Fyne'da hazır grafik bileşeni yok)
+type lineChart struct {
+	widget.BaseWidget
+
+	mu      sync.Mutex
+	series  []chartSeries
+	formatX func(float64) string
+	formatY func(float64) string
+}
+
+// newLineChart yeni bir çizgi grafiği oluşturur; format fonksiyonları eksen etiketleri içindir
+func newLineChart(formatX, formatY func(float64) string) *lineChart {
+	c := &lineChart{formatX: formatX, formatY: formatY}
+	c.ExtendBaseWidget(c)
+	return c
+}
+
+// SetSeries grafiği yeni verilerle çizer
+func (c *lineChart) SetSeries(series []chartSeries) {
+	c.mu.Lock()
+	c.series = series
+	c.mu.Unlock()
+	c.Refresh()
+}
+
+func (c *lineChart) CreateRenderer() fyne.WidgetRenderer {
+	r := &lineChartRenderer{chart: c}
+	r.background = canvas.NewRectangle(color.RGBA{R: 10, G: 20, B: 30, A: 255})
+	return r
+}
+
+// lineChartRenderer her Layout/Refresh'te çizgi nesnelerini yeniden üretir
+type lineChartRenderer struct {
+	chart      *lineChart
+	background *canvas.Rectangle
+	objects    []fyne.CanvasObject
+	size       fyne.Size
+}
+
+const (
+	chartPaddingLeft   = 60
+	chartPaddingBottom = 20
+	chartPaddingTop    = 20
+	chartPaddingRight  = 10
+)
+
+var (
+	chartAxisColor  = color.RGBA{R: 90, G: 90, B: 90, A: 255}
+	chartLabelColor = color.RGBA{R: 200, G: 190, B: 170, A: 255}
+)
+
+func (r *lineChartRenderer) Layout(size fyne.Size) {
+	r.size = size
+	r.build()
+}
+
+func (r *lineChartRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(300, 160)
+}
+
+func (r *lineChartRenderer) Refresh() {
+	r.build()
+	canvas.Refresh(r.chart)
+}
+
+func (r *lineChartRenderer) Objects() []fyne.CanvasObject {
+	return r.objects
+}
+
+func (r *lineChartRenderer) Destroy() {}
+
+// build eksenleri, sıfır çizgisini, seri çizgilerini ve etiketleri üretir
+func (r *lineChartRenderer) build() {
+	r.chart.mu.Lock()
+	series := r.chart.series
+	r.chart.mu.Unlock()
+
+	size := r.size
+	r.background.Resize(size)
+	r.background.Move(fyne.NewPos(0, 0))
+	objects := []fyne.CanvasObject{r.background}
+
+	minX, maxX := math.Inf(1), math.Inf(-1)
+	minY, maxY := 0.0, 0.0 // Sıfır her zaman görünsün (fark grafikleri için)
+	for _, s := range series {
+		for _, p := range s.Points {
+			minX, maxX = math.Min(minX, p.X), math.Max(maxX, p.X)
+			minY, maxY = math.Min(minY, p.Y), math.Max(maxY, p.Y)
+		}
+	}
+	if math.IsInf(minX, 1) {
+		r.objects = objects
+		return
+	}
+	if maxX == minX {
+		maxX = minX + 1
+	}
+	if maxY == minY {
+		maxY = minY + 1
+	}
+
+	plotW := size.Width - chartPaddingLeft - chartPaddingRight
+	plotH := size.Height - chartPaddingTop - chartPaddingBottom
+	toPos := func(p chartPoint) fyne.Position {
+		x := chartPaddingLeft + float32((p.X-minX)/(maxX-minX))*plotW
+		y := chartPaddingTop + plotH - float32((p.Y-minY)/(maxY-minY))*plotH
+		return fyne.NewPos(x, y)
+	}
+
+	// Eksenler ve sıfır çizgisi
+	objects = append(objects,
+		chartLine(fyne.NewPos(chartPaddingLeft, chartPaddingTop), fyne.NewPos(chartPaddingLeft, chartPaddingTop+plotH), chartAxisColor),
+		chartLine(toPos(chartPoint{minX, 0}), toPos(chartPoint{maxX, 0}), chartAxisColor),
+	)
+
+	for _, s := range series {
+		for i := 1; i < len(s.Points); i++ {
+			objects = append(objects, chartLine(toPos(s.Points[i-1]), toPos(s.Points[i]), s.Color))
+		}
+	}
+
+	// Eksen etiketleri
+	objects = append(objects,
+		chartText(r.chart.formatY(maxY), fyne.NewPos(2, chartPaddingTop-8)),
+		chartText(r.chart.formatY(minY), fyne.NewPos(2, chartPaddingTop+plotH-8)),
+		chartText(r.chart.formatX(minX), fyne.NewPos(chartPaddingLeft, chartPaddingTop+plotH+2)),
+		chartText(r.chart.formatX(maxX), fyne.NewPos(size.Width-chartPaddingRight-40, chartPaddingTop+plotH+2)),
+	)
+
+	// Lejant
+	x := float32(chartPaddingLeft)
+	for _, s := range series {
+		legend := canvas.NewText("■ "+s.Name, s.Color)
+		legend.TextSize = 11
+		legend.Move(fyne.NewPos(x, 2))
+		objects = append(objects, legend)
+		x += legend.MinSize().Width + 12
+	}
+
+	r.objects = objects
+}
+
+func chartLine(from, to fyne.Position, c color.Color) *canvas.Line {
+	line := canvas.NewLine(c)
+	line.StrokeWidth = 2
+	line.Position1 = from
+	line.Position2 = to
+	return line
+}
+
+func chartText(text string, pos fyne.Position) *canvas.Text {
+	t := canvas.NewText(text, chartLabelColor)
+	t.TextSize = 11
+	t.Move(pos)
+	return t
+}
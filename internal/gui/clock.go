@@ -0,0 +1,41 @@
+package gui
+
+import (
+	"sync"
+	"time"
+)
+
+// gameClock servisin bildirdiği oyun süresini polling aralıklarında duvar
+// saatiyle ilerletir, böylece sayaçlar 3 saniyede bir zıplamaz
+type gameClock struct {
+	mu        sync.Mutex
+	gameTime  float64
+	updatedAt time.Time
+	running   bool
+}
+
+// Set servisten gelen oyun süresini kaydeder
+func (c *gameClock) Set(gameTime float64, running bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gameTime = gameTime
+	c.updatedAt = time.Now()
+	c.running = running
+}
+
+// Now tahmini güncel oyun süresi (saniye)
+func (c *gameClock) Now() float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.running || c.updatedAt.IsZero() {
+		return c.gameTime
+	}
+	return c.gameTime + time.Since(c.updatedAt).Seconds()
+}
+
+// Running oyun içinde olup olmadığımız
+func (c *gameClock) Running() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.running
+}
@@ -0,0 +1,106 @@
+package gui
+
+import (
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"lol-helper/internal/i18n"
+	"lol-helper/internal/lol"
+)
+
+// compositionPanel iki takımın kompozisyon analizini yan yana gösteren kompakt panel
+type compositionPanel struct {
+	container fyne.CanvasObject
+	titles    [2]*widget.Label
+	bodies    [2]*widget.Label
+
+	lastText string
+}
+
+// newCompositionPanel yeni bir kompozisyon paneli oluşturur
+func newCompositionPanel() *compositionPanel {
+	p := &compositionPanel{}
+	columns := make([]fyne.CanvasObject, 0, 2)
+	for i := range p.titles {
+		p.titles[i] = widget.NewLabelWithStyle("", fyne.TextAlignLeading, fyne.TextStyle{Bold: true})
+		p.bodies[i] = widget.NewLabel("")
+		p.bodies[i].Wrapping = fyne.TextWrapWord
+		columns = append(columns, container.NewVBox(p.titles[i], p.bodies[i]))
+	}
+	p.container = widget.NewCard("", i18n.T("composition.title"), container.NewGridWithColumns(2, columns...))
+	p.container.Hide()
+	return p
+}
+
+// Update analiz değiştiyse paneli yeniler; analiz yoksa paneli gizler
+func (p *compositionPanel) Update(analysis *lol.CompositionAnalysis) {
+	if analysis == nil || len(analysis.Teams) == 0 {
+		p.lastText = ""
+		p.container.Hide()
+		return
+	}
+
+	titles := make([]string, len(p.titles))
+	bodies := make([]string, len(p.bodies))
+	for i, team := range analysis.Teams {
+		if i >= len(p.titles) {
+			break
+		}
+		titles[i] = compositionTitle(team, analysis.LocalTeam)
+		bodies[i] = formatComposition(team)
+	}
+
+	text := strings.Join(titles, "|") + strings.Join(bodies, "|")
+	if text != p.lastText {
+		p.lastText = text
+		for i := range p.titles {
+			p.titles[i].SetText(titles[i])
+			p.bodies[i].SetText(bodies[i])
+		}
+	}
+	p.container.Show()
+}
+
+// compositionTitle takım başlığını "Mavi (Biz)" biçiminde döner
+func compositionTitle(team lol.TeamComposition, localTeam string) string {
+	title := teamDisplayName(team.Team)
+	switch {
+	case localTeam == "":
+	case team.Team == localTeam:
+		title += " " + i18n.T("team.us")
+	default:
+		title += " " + i18n.T("team.them")
+	}
+	return title
+}
+
+// formatComposition takım analizini birkaç satırlık özete çevirir
+func formatComposition(team lol.TeamComposition) string {
+	if len(team.Champions) == 0 {
+		return i18n.T("composition.waiting")
+	}
+
+	lines := []string{
+		i18n.T("composition.damage", team.Damage.Physical*100, team.Damage.Magic*100, team.Damage.True*100),
+		i18n.T("composition.summary", team.Frontline, team.HardCC, team.Archetype.Label()),
+	}
+	if len(team.Engage) > 0 {
+		lines = append(lines, i18n.T("composition.engage", strings.Join(team.Engage, ", ")))
+	}
+	if len(team.Disengage) > 0 {
+		lines = append(lines, i18n.T("composition.disengage", strings.Join(team.Disengage, ", ")))
+	}
+	for _, c := range team.WinConditions {
+		lines = append(lines, "✓ "+c)
+	}
+	for _, w := range team.Warnings {
+		lines = append(lines, "⚠ "+w)
+	}
+	if len(team.Unknown) > 0 {
+		lines = append(lines, i18n.T("composition.unknown", strings.Join(team.Unknown, ", ")))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,120 @@
+package gui
+
+import (
+	"fmt"
+	"image/color"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"lol-helper/internal/i18n"
+	"lol-helper/internal/lol"
+)
+
+// rowHighlightDuration bildirim gelen oyuncu satırının vurgulu kalacağı süre
+const rowHighlightDuration = 4 * time.Second
+
+var rowHighlightColor = color.RGBA{R: 200, G: 170, B: 110, A: 70}
+
+// notificationFeed item tamamlama ve seviye bildirimlerinin kayan listesi
+type notificationFeed struct {
+	container fyne.CanvasObject
+	list      *fyne.Container
+	scroll    *container.Scroll
+	lastID    int
+}
+
+// newNotificationFeed yeni bir bildirim akışı oluşturur
+func newNotificationFeed() *notificationFeed {
+	f := &notificationFeed{list: container.NewVBox()}
+	f.scroll = container.NewVScroll(f.list)
+	f.scroll.SetMinSize(fyne.NewSize(260, 0))
+	f.container = container.NewBorder(
+		widget.NewLabelWithStyle(i18n.T("feed.title"), fyne.TextAlignCenter, fyne.TextStyle{Bold: true}),
+		nil, nil, nil,
+		f.scroll,
+	)
+	return f
+}
+
+// Update yeni bildirimleri listeye ekler ve sadece yeni gelenleri döner
+func (f *notificationFeed) Update(notifications []lol.Notification, localTeam string) []lol.Notification {
+	// Yeni oyunda ID'ler baştan başlamaz ama liste boşalır
+	if len(notifications) == 0 && f.lastID != 0 && len(f.list.Objects) > 0 {
+		f.list.Objects = nil
+		f.list.Refresh()
+	}
+
+	var fresh []lol.Notification
+	for _, n := range notifications {
+		if n.ID <= f.lastID {
+			continue
+		}
+		f.lastID = n.ID
+		fresh = append(fresh, n)
+
+		label := widget.NewLabel(formatNotification(n, localTeam))
+		label.Wrapping = fyne.TextWrapWord
+		f.list.Add(label)
+	}
+
+	if len(fresh) > 0 {
+		// Listeyi sınırla, en eski bildirimler üstten düşer
+		if extra := len(f.list.Objects) - 50; extra > 0 {
+			f.list.Objects = f.list.Objects[extra:]
+		}
+		f.list.Refresh()
+		f.scroll.ScrollToBottom()
+	}
+	return fresh
+}
+
+// formatNotification "[14:32] Rakip Zed: Youmuu's Ghostblade tamamladı" biçiminde metin üretir
+func formatNotification(n lol.Notification, localTeam string) string {
+	side := teamDisplayName(n.Team)
+	if localTeam != "" {
+		side = i18n.T("feed.enemy")
+		if n.Team == localTeam {
+			side = i18n.T("feed.ally")
+		}
+	}
+
+	var what string
+	switch n.Kind {
+	case lol.NotificationItemCompleted:
+		what = i18n.T("feed.itemCompleted", n.ItemName)
+	case lol.NotificationLevelSpike:
+		what = i18n.T("feed.levelSpike", n.Level)
+	}
+	return fmt.Sprintf("[%s] %s %s: %s", formatGameTime(n.GameTime), side, n.Champion, what)
+}
+
+// highlightPlayer oyuncunun satırını birkaç saniyeliğine vurgular
+func (mw *MainWindow) highlightPlayer(name string) {
+	mw.rowMu.Lock()
+	bg, ok := mw.rowBackgrounds[name]
+	mw.rowMu.Unlock()
+	if !ok {
+		return
+	}
+
+	bg.FillColor = rowHighlightColor
+	bg.Refresh()
+
+	time.AfterFunc(rowHighlightDuration, func() {
+		bg.FillColor = mw.rowBaseColor(name)
+		bg.Refresh()
+	})
+}
+
+// newRowBackground satır vurgusu için arka plan oluşturur ve oyuncu adına kaydeder
+func (mw *MainWindow) newRowBackground(name string) *canvas.Rectangle {
+	bg := canvas.NewRectangle(color.Transparent)
+	mw.rowMu.Lock()
+	mw.rowBackgrounds[name] = bg
+	mw.rowMu.Unlock()
+	return bg
+}
@@ -0,0 +1,104 @@
+package gui
+
+import (
+	"fmt"
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"lol-helper/internal/i18n"
+	"lol-helper/internal/lol"
+)
+
+var goldDiffColor = color.RGBA{R: 200, G: 170, B: 110, A: 255}
+
+// positionKeys Live Client pozisyonlarının mesaj anahtarları
+var positionKeys = map[string]string{
+	"TOP":     "position.top",
+	"JUNGLE":  "position.jungle",
+	"MIDDLE":  "position.middle",
+	"BOTTOM":  "position.bottom",
+	"UTILITY": "position.utility",
+}
+
+// positionName pozisyonun ekranda gösterilen adı; bilinmeyen pozisyon olduğu gibi döner
+func positionName(position string) string {
+	if key, ok := positionKeys[position]; ok {
+		return i18n.T(key)
+	}
+	return position
+}
+
+// goldPanel takım altın farkı grafiği ve koridor bazında item değeri farkları
+type goldPanel struct {
+	container   fyne.CanvasObject
+	chart       *lineChart
+	totalsLabel *widget.Label
+	lanesBox    *fyne.Container
+}
+
+// newGoldPanel yeni bir altın paneli oluşturur
+func newGoldPanel() *goldPanel {
+	p := &goldPanel{
+		chart: newLineChart(formatGameTime, func(v float64) string {
+			return fmt.Sprintf("%+.1fk", v/1000)
+		}),
+		totalsLabel: widget.NewLabelWithStyle("", fyne.TextAlignCenter, fyne.TextStyle{Bold: true}),
+		lanesBox:    container.NewVBox(),
+	}
+
+	p.container = container.NewBorder(
+		container.NewVBox(
+			widget.NewLabelWithStyle(i18n.T("gold.title"), fyne.TextAlignCenter, fyne.TextStyle{Bold: true}),
+			p.totalsLabel,
+		),
+		container.NewVBox(
+			widget.NewSeparator(),
+			widget.NewLabelWithStyle(i18n.T("gold.lanes"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+			p.lanesBox,
+		),
+		nil, nil,
+		p.chart,
+	)
+	return p
+}
+
+// Update panelleri yeni tahminle günceller
+func (p *goldPanel) Update(est lol.GoldEstimate) {
+	order, chaos := est.TeamValues["ORDER"], est.TeamValues["CHAOS"]
+	p.totalsLabel.SetText(i18n.T("gold.totals", order, chaos, order-chaos))
+
+	points := make([]chartPoint, 0, len(est.History))
+	for _, s := range est.History {
+		points = append(points, chartPoint{X: s.GameTime, Y: float64(s.Diff())})
+	}
+	p.chart.SetSeries([]chartSeries{{Name: i18n.T("gold.series"), Color: goldDiffColor, Points: points}})
+
+	rows := make([]fyne.CanvasObject, 0, len(est.Lanes))
+	for _, lane := range est.Lanes {
+		rows = append(rows, widget.NewLabel(fmt.Sprintf("%-7s %s (%d) vs %s (%d)  %+d",
+			positionName(lane.Position),
+			lane.OrderName, lane.OrderValue,
+			lane.ChaosName, lane.ChaosValue,
+			lane.OrderValue-lane.ChaosValue,
+		)))
+	}
+	p.lanesBox.Objects = rows
+	p.lanesBox.Refresh()
+}
+
+// showGoldTab altın sekmesini skor tablosunun hemen arkasına ekler veya çıkarır
+func (mw *MainWindow) showGoldTab(show bool) {
+	items := mw.centerTabs.Items
+	shown := len(items) > 1 && items[1] == mw.goldTab
+	if show == shown {
+		return
+	}
+	if show {
+		mw.centerTabs.SetItems(append([]*container.TabItem{items[0], mw.goldTab}, items[1:]...))
+		return
+	}
+	mw.centerTabs.Remove(mw.goldTab)
+}
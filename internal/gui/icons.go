@@ -0,0 +1,85 @@
+package gui
+
+import (
+	"strconv"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+
+	"lol-helper/internal/assets"
+)
+
+// itemIconSize skor tablosu ve AI önerilerindeki item ikonlarının boyutu
+const itemIconSize = 32
+
+// icon ikonu arka planda yükleyen bir görünüm döner. İkon gelene kadar (veya
+// yüklenemezse) yerinde aynı boyda bir kutu durur; çağıran hiçbir zaman indirmeyi beklemez.
+func (mw *MainWindow) icon(key assets.Key, size float32) fyne.CanvasObject {
+	placeholder := canvas.NewRectangle(itemPlaceholderColor)
+	placeholder.SetMinSize(fyne.NewSize(size, size))
+	img := canvas.NewImageFromResource(nil)
+	img.FillMode = canvas.ImageFillContain
+	img.SetMinSize(fyne.NewSize(size, size))
+
+	name := string(key.Kind) + "/" + key.Patch + "/" + key.Name
+	if data, ok := mw.assets.Get(key); ok {
+		img.Resource = fyne.NewStaticResource(name, data)
+		placeholder.Hide()
+		return container.NewStack(placeholder, img)
+	}
+
+	img.Hide()
+	mw.assets.Load(key, func(data []byte, err error) {
+		if err != nil {
+			return
+		}
+		img.Resource = fyne.NewStaticResource(name, data)
+		placeholder.Hide()
+		img.Show()
+		img.Refresh()
+	})
+	return container.NewStack(placeholder, img)
+}
+
+// itemIcon yüklü yamanın item ikonu; yama henüz yüklenmediyse yer tutucu
+func (mw *MainWindow) itemIcon(itemID int) fyne.CanvasObject {
+	data := mw.store.Current()
+	if data == nil {
+		return itemPlaceholder()
+	}
+	return mw.icon(assets.Key{Kind: assets.KindItem, Patch: data.Version, Name: strconv.Itoa(itemID)}, itemIconSize)
+}
+
+// runeIcon rün, ağaç veya stat shard ikonu (rün ikonları sürümsüzdür)
+func (mw *MainWindow) runeIcon(icon string, size float32) fyne.CanvasObject {
+	if icon == "" {
+		return emptyIcon(size)
+	}
+	return mw.icon(assets.Key{Kind: assets.KindRune, Name: icon}, size)
+}
+
+// championIcon yüklü yamanın şampiyon portresi (key: Data Dragon kimliği, "MonkeyKing")
+func (mw *MainWindow) championIcon(key string, size float32) fyne.CanvasObject {
+	data := mw.store.Current()
+	if data == nil || key == "" {
+		return emptyIcon(size)
+	}
+	return mw.icon(assets.Key{Kind: assets.KindChampion, Patch: data.Version, Name: key}, size)
+}
+
+// spellIcon yüklü yamanın sihirdar büyüsü ikonu (key: "SummonerFlash")
+func (mw *MainWindow) spellIcon(key string, size float32) fyne.CanvasObject {
+	data := mw.store.Current()
+	if data == nil || key == "" {
+		return emptyIcon(size)
+	}
+	return mw.icon(assets.Key{Kind: assets.KindSpell, Patch: data.Version, Name: key}, size)
+}
+
+// emptyIcon bilinmeyen veya boş ikon yuvası
+func emptyIcon(size float32) fyne.CanvasObject {
+	rect := canvas.NewRectangle(emptySlotColor)
+	rect.SetMinSize(fyne.NewSize(size, size))
+	return rect
+}
@@ -0,0 +1,213 @@
+package gui
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"lol-helper/internal/i18n"
+	"lol-helper/internal/lol"
+)
+
+// objectiveAlertThresholds hedef doğmadan kaç saniye önce uyarı verileceği
+var objectiveAlertThresholds = []float64{60, 30}
+
+// objectiveStrip ejderha, baron, herald, kurtçuk ve inhibitör sayaçlarını gösteren şerit
+type objectiveStrip struct {
+	app   fyne.App
+	clock *gameClock
+
+	container    *fyne.Container
+	timersBox    *fyne.Container
+	clockLabel   *widget.Label
+	dragonsLabel *widget.Label
+	alertsCheck  *widget.Check
+	timerLabels  map[string]*widget.Label
+	timerKeys    string
+	renderMu     sync.Mutex // render hem ticker'dan hem UpdateUI'dan çağrılır
+
+	mu       sync.Mutex
+	state    lol.ObjectiveState
+	lastTime int
+	alerted  map[string]bool
+}
+
+// newObjectiveStrip yeni bir hedef şeridi oluşturur
+func newObjectiveStrip(app fyne.App, clock *gameClock) *objectiveStrip {
+	s := &objectiveStrip{
+		app:          app,
+		clock:        clock,
+		timersBox:    container.NewHBox(),
+		clockLabel:   widget.NewLabelWithStyle("00:00", fyne.TextAlignLeading, fyne.TextStyle{Bold: true, Monospace: true}),
+		dragonsLabel: widget.NewLabel(""),
+		alertsCheck:  widget.NewCheck(i18n.T("objective.alerts"), nil),
+		timerLabels:  make(map[string]*widget.Label),
+		alerted:      make(map[string]bool),
+	}
+	s.alertsCheck.SetChecked(true)
+
+	s.container = container.NewVBox(
+		container.NewHBox(s.clockLabel, widget.NewSeparator(), s.timersBox, widget.NewSeparator(), s.alertsCheck),
+		s.dragonsLabel,
+	)
+	s.container.Hide()
+	return s
+}
+
+// Update servisten gelen yeni hedef durumunu kaydeder
+func (s *objectiveStrip) Update(state lol.ObjectiveState, gameTime int) {
+	s.mu.Lock()
+	// Yeni oyun başladıysa eski uyarı kayıtlarını temizle
+	if gameTime < s.lastTime {
+		s.alerted = make(map[string]bool)
+	}
+	s.state = state
+	s.lastTime = gameTime
+	s.mu.Unlock()
+
+	s.render()
+}
+
+// render etiketleri mevcut (tahmini) oyun süresine göre günceller
+func (s *objectiveStrip) render() {
+	s.renderMu.Lock()
+	defer s.renderMu.Unlock()
+
+	s.mu.Lock()
+	state := s.state
+	s.mu.Unlock()
+
+	if !s.clock.Running() {
+		s.container.Hide()
+		return
+	}
+	now := s.clock.Now()
+	s.container.Show()
+	s.clockLabel.SetText(formatGameTime(now))
+
+	// Etiket setini sadece sayaç listesi değiştiğinde yeniden kur (blinking önlemek için)
+	keys := make([]string, 0, len(state.Timers))
+	for _, t := range state.Timers {
+		keys = append(keys, t.Key)
+	}
+	joined := strings.Join(keys, ",")
+	if joined != s.timerKeys {
+		s.timerKeys = joined
+		s.timerLabels = make(map[string]*widget.Label, len(keys))
+		s.timersBox.Objects = nil
+		for _, key := range keys {
+			label := widget.NewLabel("")
+			s.timerLabels[key] = label
+			s.timersBox.Add(label)
+		}
+		s.timersBox.Refresh()
+	}
+
+	for _, t := range state.Timers {
+		s.timerLabels[t.Key].SetText(formatObjectiveTimer(t, now))
+		s.checkAlert(t, now)
+	}
+
+	s.dragonsLabel.SetText(formatDragonCounts(state))
+}
+
+// checkAlert sayaç bir uyarı eşiğini yeni geçtiyse bildirim gönderir
+func (s *objectiveStrip) checkAlert(t lol.ObjectiveTimer, now float64) {
+	if !s.alertsCheck.Checked || t.Alive {
+		return
+	}
+
+	remaining := t.Remaining(now)
+	for _, threshold := range objectiveAlertThresholds {
+		// Eşiğin hemen altındaki birkaç saniyelik pencere; geç açılan uygulamada eski uyarılar gelmesin
+		if remaining > threshold || remaining <= threshold-5 {
+			continue
+		}
+
+		key := fmt.Sprintf("%s@%.0f@%.0f", t.Key, t.NextSpawn, threshold)
+		s.mu.Lock()
+		done := s.alerted[key]
+		s.alerted[key] = true
+		s.mu.Unlock()
+		if done {
+			continue
+		}
+
+		s.app.SendNotification(fyne.NewNotification(
+			"LoL Helper",
+			i18n.T("objective.spawning", objectiveName(t), threshold),
+		))
+	}
+}
+
+// objectiveName hedefin ekranda gösterilecek adı
+func objectiveName(t lol.ObjectiveTimer) string {
+	switch t.Objective {
+	case lol.ObjectiveDragon:
+		return i18n.T("objective.dragon")
+	case lol.ObjectiveElder:
+		return i18n.T("objective.elder")
+	case lol.ObjectiveBaron:
+		return i18n.T("objective.baron")
+	case lol.ObjectiveHerald:
+		return i18n.T("objective.herald")
+	case lol.ObjectiveGrubs:
+		return i18n.T("objective.grubs")
+	case lol.ObjectiveInhibitor:
+		return i18n.T("objective.inhibitor", t.Detail)
+	}
+	return string(t.Objective)
+}
+
+// formatObjectiveTimer "Baron: 03:12 (Son: Mavi)" biçiminde metin üretir
+func formatObjectiveTimer(t lol.ObjectiveTimer, now float64) string {
+	status := i18n.T("objective.ready")
+	if remaining := t.Remaining(now); remaining > 0 {
+		status = formatGameTime(remaining)
+	}
+
+	text := fmt.Sprintf("%s: %s", objectiveName(t), status)
+	if t.LastTakenBy != "" {
+		text += " " + i18n.T("objective.lastTaken", teamDisplayName(t.LastTakenBy))
+	}
+	return text
+}
+
+// formatDragonCounts takım bazında ejderha sayılarını ve ruhu yazar
+func formatDragonCounts(state lol.ObjectiveState) string {
+	var parts []string
+	for _, team := range []string{"ORDER", "CHAOS"} {
+		part := fmt.Sprintf("%s: %d", teamDisplayName(team), state.DragonCount[team])
+		if types := state.DragonTypes[team]; len(types) > 0 {
+			part += " (" + strings.Join(types, ", ") + ")"
+		}
+		parts = append(parts, part)
+	}
+
+	text := i18n.T("objective.dragons", strings.Join(parts, " | "))
+	if state.SoulTeam != "" {
+		text += " | " + i18n.T("objective.soul", teamDisplayName(state.SoulTeam))
+	}
+	return text
+}
+
+// teamDisplayName ORDER/CHAOS takım kodunu renk adına çevirir
+func teamDisplayName(team string) string {
+	switch team {
+	case "ORDER":
+		return i18n.T("team.blue")
+	case "CHAOS":
+		return i18n.T("team.red")
+	}
+	return team
+}
+
+// formatGameTime saniyeyi mm:ss biçimine çevirir
+func formatGameTime(seconds float64) string {
+	total := int(seconds)
+	return fmt.Sprintf("%02d:%02d", total/60, total%60)
+}
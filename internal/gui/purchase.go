@@ -0,0 +1,98 @@
+package gui
+
+import (
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"lol-helper/internal/i18n"
+	"lol-helper/internal/lol"
+)
+
+// purchasePanel önerilen itemlerin yanında şu anki altınla alınabilecekleri gösterir
+type purchasePanel struct {
+	container fyne.CanvasObject
+	label     *widget.Label
+
+	lastText string
+}
+
+// newPurchasePanel yeni bir alışveriş paneli oluşturur
+func newPurchasePanel() *purchasePanel {
+	p := &purchasePanel{label: widget.NewLabel("")}
+	p.label.Wrapping = fyne.TextWrapWord
+	p.container = container.NewVBox(
+		widget.NewLabelWithStyle(i18n.T("purchase.title"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		p.label,
+	)
+	p.container.Hide()
+	return p
+}
+
+// Update plan değiştiyse paneli yeniler; plan yoksa paneli gizler
+func (p *purchasePanel) Update(plans []lol.PurchasePlan) {
+	if len(plans) == 0 {
+		p.lastText = ""
+		p.container.Hide()
+		return
+	}
+	text := formatPurchasePlans(plans)
+	if text != p.lastText {
+		p.lastText = text
+		p.label.SetText(text)
+	}
+	p.container.Show()
+}
+
+// formatPurchasePlans her öneriyi tek satırda yazar:
+// "Infinity Edge: tamamla 1250 (bileşen indirimi 2200)" veya "Bloodthirster: 1400 eksik → B. F. Sword 1300"
+func formatPurchasePlans(plans []lol.PurchasePlan) string {
+	lines := make([]string, 0, len(plans))
+	for _, plan := range plans {
+		var line string
+		switch {
+		case plan.Owned:
+			line = i18n.T("purchase.owned", plan.Name)
+		case plan.Unavailable:
+			line = i18n.T("purchase.unavailable", plan.Name)
+		case plan.Affordable && plan.Discount > 0:
+			line = i18n.T("purchase.completeDiscount", plan.Name, plan.Remaining, plan.Discount)
+		case plan.Affordable:
+			line = i18n.T("purchase.complete", plan.Name, plan.Remaining)
+		case len(plan.Buys) > 0:
+			buys := make([]string, 0, len(plan.Buys))
+			for _, b := range plan.Buys {
+				buys = append(buys, i18n.T("purchase.step", b.Name, b.Cost))
+			}
+			line = i18n.T("purchase.components", plan.Name, plan.Remaining, strings.Join(buys, ", "))
+		default:
+			line = i18n.T("purchase.short", plan.Name, plan.Remaining)
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// suggestedItemView AI'ın önerdiği itemi ikonuyla gösterir. AI'ın yazdığı ad
+// yamadakinden farklıysa ikisi birlikte yazılır ki yanlış eşleşme fark edilsin;
+// tanınmayan ad uyarı rengiyle gösterilir.
+func (mw *MainWindow) suggestedItemView(s lol.ItemSuggestion) fyne.CanvasObject {
+	if s.ItemID == 0 {
+		label := widget.NewLabel(s.Name)
+		if s.Unresolved {
+			label.SetText(i18n.T("ai.items.unresolved", s.Name))
+			label.Importance = widget.WarningImportance
+		}
+		return label
+	}
+	name := s.Name
+	if !strings.EqualFold(strings.TrimSpace(s.Name), s.ItemName) {
+		name = i18n.T("ai.items.matched", s.Name, s.ItemName)
+	}
+	return container.NewVBox(
+		mw.itemIcon(s.ItemID),
+		widget.NewLabelWithStyle(name, fyne.TextAlignCenter, fyne.TextStyle{}),
+	)
+}
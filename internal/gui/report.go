@@ -0,0 +1,75 @@
+package gui
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"lol-helper/internal/appdir"
+	"lol-helper/internal/i18n"
+	"lol-helper/internal/lol"
+	"lol-helper/internal/report"
+)
+
+// showReportDialog kayıtlı oyunlardan birini seçtirip maç raporunu üretir
+func (mw *MainWindow) showReportDialog() {
+	timelineDir, err := appdir.Dir("timelines")
+	if err != nil {
+		dialog.ShowError(err, mw.window)
+		return
+	}
+	games, err := lol.ListTimelines(timelineDir)
+	if err != nil || len(games) == 0 {
+		dialog.ShowInformation(i18n.T("report.title"), i18n.T("report.noGames"), mw.window)
+		return
+	}
+
+	paths := make(map[string]string, len(games))
+	options := make([]string, 0, len(games))
+	for _, g := range games {
+		label := fmt.Sprintf("%s %s (%s) %s", g.Header.StartedAt.Format("02.01 15:04"), g.Header.Champion, formatGameTime(g.Duration), g.Result)
+		paths[label] = g.Path
+		options = append(options, label)
+	}
+
+	sel := widget.NewSelect(options, nil)
+	sel.SetSelected(options[0])
+
+	items := []*widget.FormItem{widget.NewFormItem(i18n.T("report.game"), sel)}
+	dialog.ShowForm(i18n.T("report.title"), i18n.T("report.generate"), i18n.T("common.cancel"), items, func(ok bool) {
+		if ok {
+			go mw.generateReport(paths[sel.Selected])
+		}
+	}, mw.window)
+}
+
+// generateReport raporu üretir (ikon indirme sürebileceği için arka planda) ve HTML'i açar
+func (mw *MainWindow) generateReport(timelinePath string) {
+	mw.statusLabel.SetText(i18n.T("status.reportGenerating"))
+
+	outDir, err := appdir.Dir("reports")
+	if err != nil {
+		dialog.ShowError(err, mw.window)
+		return
+	}
+	mdPath, htmlPath, err := report.Generate(timelinePath, outDir, report.AssetIcons{Service: mw.assets})
+	if err != nil {
+		dialog.ShowError(err, mw.window)
+		return
+	}
+
+	mw.statusLabel.SetText(i18n.T("status.reportGenerated"))
+	dialog.ShowInformation(i18n.T("report.title"), i18n.T("report.saved", htmlPath, mdPath), mw.window)
+
+	abs, err := filepath.Abs(htmlPath)
+	if err != nil {
+		return
+	}
+	if err := mw.app.OpenURL(&url.URL{Scheme: "file", Path: filepath.ToSlash(abs)}); err != nil {
+		fyne.LogError("Rapor açılamadı", err)
+	}
+}
@@ -0,0 +1,63 @@
+package gui
+
+import (
+	"regexp"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"lol-helper/internal/i18n"
+	"lol-helper/internal/lcu"
+	"lol-helper/internal/staticdata"
+)
+
+// runeIconSize oyuncu detayındaki rün ikonlarının boyutu
+const runeIconSize = 24
+
+// descriptionTags Data Dragon açıklamalarındaki biçimlendirme etiketleri ("<br>", "<lol-uikit-tooltipped-keyword>")
+var descriptionTags = regexp.MustCompile(`<[^>]*>`)
+
+// runePageView oyuncunun rün sayfasını ikonlarıyla listeler. Yama henüz
+// yüklenmediyse Live Client'ın verdiği adlar yazılır.
+func (mw *MainWindow) runePageView(p lcu.LivePlayer) fyne.CanvasObject {
+	data := mw.store.Current()
+	if data == nil || mw.lastState == nil {
+		return widget.NewLabel(i18n.T("detail.runes",
+			p.Runes.Keystone.DisplayName, p.Runes.PrimaryRuneTree.DisplayName, p.Runes.SecondaryRuneTree.DisplayName))
+	}
+
+	page := mw.lastState.Game.RunePage(data, p)
+	box := container.NewVBox()
+	if page.Keystone != nil {
+		box.Add(mw.runeRow(page.Keystone.Icon, page.Keystone.Name, page.Keystone.ShortDesc))
+	}
+	for _, tree := range []*staticdata.RuneTree{page.Primary, page.Secondary} {
+		if tree != nil {
+			box.Add(mw.runeRow(tree.Icon, tree.Name, ""))
+		}
+	}
+	for _, r := range page.Runes {
+		box.Add(mw.runeRow(r.Icon, r.Name, r.ShortDesc))
+	}
+	for _, sh := range page.Shards {
+		box.Add(mw.runeRow(sh.Icon, sh.Description, ""))
+	}
+	if !page.Complete() {
+		note := widget.NewLabel(i18n.T("detail.runes.partial"))
+		note.Importance = widget.LowImportance
+		box.Add(note)
+	}
+	return box
+}
+
+// runeRow ikon, ad ve (varsa) kısa açıklamadan oluşan satır
+func (mw *MainWindow) runeRow(icon, name, desc string) fyne.CanvasObject {
+	text := name
+	if desc != "" {
+		text += " — " + descriptionTags.ReplaceAllString(desc, "")
+	}
+	label := widget.NewLabel(text)
+	label.Wrapping = fyne.TextWrapWord
+	return container.NewBorder(nil, nil, mw.runeIcon(icon, runeIconSize), nil, label)
+}
@@ -0,0 +1,135 @@
+package gui
+
+import (
+	"image/color"
+	"math"
+	"strconv"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+
+	"lol-helper/internal/lcu"
+	"lol-helper/internal/lol"
+	"lol-helper/internal/staticdata"
+)
+
+const (
+	// championCellWidth skor tablosundaki şampiyon sütununun genişliği (portre, büyü/rün ikonları ve ad)
+	championCellWidth = 220
+	// portraitSize şampiyon portresinin boyutu
+	portraitSize = 36
+	// loadoutIconSize portrenin yanındaki sihirdar büyüsü ve rün ikonlarının boyutu
+	loadoutIconSize = 17
+)
+
+var (
+	// deadShadeColor ölü oyuncunun portresini karartan katman
+	deadShadeColor = color.NRGBA{A: 170}
+	// levelBadgeColor seviye rozetinin arka planı
+	levelBadgeColor = color.NRGBA{R: 10, G: 10, B: 20, A: 220}
+)
+
+// championPortrait skor tablosundaki şampiyon portresi: sağ altta seviye rozeti,
+// oyuncu ölüyken karartma ve yeniden doğma sayacı. Satırlar sadece oyuncu listesi
+// değişince kurulduğu için seviye ve ölüm durumu her güncellemede yerinde yenilenir.
+type championPortrait struct {
+	container fyne.CanvasObject
+	level     *canvas.Text
+	dead      fyne.CanvasObject
+	respawn   *canvas.Text
+}
+
+// newChampionPortrait portreyi oluşturur ve oyuncu adıyla kaydeder
+func (mw *MainWindow) newChampionPortrait(p lcu.LivePlayer) *championPortrait {
+	cp := &championPortrait{
+		level:   canvas.NewText("", color.White),
+		respawn: canvas.NewText("", color.White),
+	}
+	cp.level.TextSize = 10
+	cp.level.TextStyle = fyne.TextStyle{Bold: true}
+	cp.respawn.TextStyle = fyne.TextStyle{Bold: true}
+	cp.respawn.Alignment = fyne.TextAlignCenter
+
+	cp.dead = container.NewStack(canvas.NewRectangle(deadShadeColor), container.NewCenter(cp.respawn))
+	cp.dead.Hide()
+	badge := container.NewStack(canvas.NewRectangle(levelBadgeColor), cp.level)
+	cp.container = container.NewStack(
+		mw.championIcon(championIconKey(mw.store.Current(), p), portraitSize),
+		cp.dead,
+		container.NewVBox(layout.NewSpacer(), container.NewHBox(layout.NewSpacer(), badge)),
+	)
+	cp.Update(p)
+
+	mw.rowMu.Lock()
+	mw.portraits[p.SummonerName] = cp
+	mw.rowMu.Unlock()
+	return cp
+}
+
+// Update seviye rozetini ve ölüm katmanını oyuncunun son durumuna göre yeniler
+func (cp *championPortrait) Update(p lcu.LivePlayer) {
+	if level := strconv.Itoa(p.Level); cp.level.Text != level {
+		cp.level.Text = level
+		cp.level.Refresh()
+	}
+	if !p.IsDead {
+		cp.dead.Hide()
+		return
+	}
+	respawn := ""
+	if p.RespawnTimer > 0 {
+		respawn = strconv.Itoa(int(math.Ceil(p.RespawnTimer)))
+	}
+	if cp.respawn.Text != respawn {
+		cp.respawn.Text = respawn
+		cp.respawn.Refresh()
+	}
+	cp.dead.Show()
+}
+
+// updatePortraits satırları yeniden kurmadan seviye ve ölüm durumlarını günceller
+func (mw *MainWindow) updatePortraits(players []lcu.LivePlayer) {
+	mw.rowMu.Lock()
+	defer mw.rowMu.Unlock()
+	for _, p := range players {
+		if cp, ok := mw.portraits[p.SummonerName]; ok {
+			cp.Update(p)
+		}
+	}
+}
+
+// championCell oyun içi skor tablosundaki gibi portre, iki sihirdar büyüsü,
+// keystone ve ikincil rün ağacı ile şampiyon adını yan yana dizer
+func (mw *MainWindow) championCell(p lcu.LivePlayer) fyne.CanvasObject {
+	data := mw.store.Current()
+	keystone, secondary := "", ""
+	if data != nil {
+		if r, ok := data.Rune(p.Runes.Keystone.ID); ok {
+			keystone = r.Icon
+		}
+		if t, ok := data.RuneTree(p.Runes.SecondaryRuneTree.ID); ok {
+			secondary = t.Icon
+		}
+	}
+
+	// Büyüler solda, rünler sağda; iki satır portre boyunu doldurur
+	loadout := container.NewGridWithColumns(2,
+		mw.spellIcon(lol.SpellKey(p.SummonerSpells.SummonerSpellOne), loadoutIconSize),
+		mw.runeIcon(keystone, loadoutIconSize),
+		mw.spellIcon(lol.SpellKey(p.SummonerSpells.SummonerSpellTwo), loadoutIconSize),
+		mw.runeIcon(secondary, loadoutIconSize),
+	)
+	name := mw.fixedLabel(p.ChampionName, championCellWidth-portraitSize-2*loadoutIconSize-2*theme.Padding(), false)
+	return container.NewHBox(mw.newChampionPortrait(p).container, loadout, name)
+}
+
+// championIconKey oyuncunun şampiyonunun yüklü yamadaki Data Dragon kimliğini bulur
+func championIconKey(data *staticdata.GameData, p lcu.LivePlayer) string {
+	if ch, ok := lol.LiveChampion(data, p); ok {
+		return ch.Key
+	}
+	return ""
+}
@@ -0,0 +1,114 @@
+package gui
+
+import (
+	"strings"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"lol-helper/internal/i18n"
+	"lol-helper/internal/lol"
+)
+
+// skillBar yetenek sırası, sıradaki yetenek ve harcanmamış puan uyarısı
+type skillBar struct {
+	container fyne.CanvasObject
+	label     *widget.Label
+	button    *widget.Button
+
+	mu       sync.Mutex
+	champion string
+}
+
+// newSkillBar yeni bir yetenek çubuğu oluşturur; onEdit "Sırayı Ayarla" düğmesine basılınca çağrılır
+func newSkillBar(onEdit func(champion string)) *skillBar {
+	b := &skillBar{label: widget.NewLabel(i18n.T("skills.label", "-"))}
+	b.button = widget.NewButton(i18n.T("skills.edit"), func() {
+		b.mu.Lock()
+		champion := b.champion
+		b.mu.Unlock()
+		if champion != "" {
+			onEdit(champion)
+		}
+	})
+	b.button.Disable()
+	b.container = container.NewBorder(nil, nil, nil, b.button, b.label)
+	return b
+}
+
+// Update yetenek durumunu gösterir
+func (b *skillBar) Update(st lol.SkillState) {
+	b.mu.Lock()
+	b.champion = st.Champion
+	b.mu.Unlock()
+	if st.Champion != "" {
+		b.button.Enable()
+	}
+
+	b.label.Importance = widget.MediumImportance
+	if st.Unspent > 0 {
+		b.label.Importance = widget.WarningImportance
+	}
+	b.label.SetText(formatSkillState(st))
+}
+
+// formatSkillState "Q > E > W (AI) | Sıradaki: Q | 1 puan harcanmadı" gibi bir metin üretir
+func formatSkillState(st lol.SkillState) string {
+	if st.Note != "" {
+		return i18n.T("skills.label", st.Note)
+	}
+	if st.Champion == "" {
+		return i18n.T("skills.label", "-")
+	}
+
+	var parts []string
+	if st.Order == nil {
+		parts = append(parts, i18n.T("skills.waiting"))
+	} else {
+		source := ""
+		if st.Order.Source == lol.SkillSourceAI {
+			source = " (AI)"
+		}
+		parts = append(parts, st.Order.String()+source)
+		if st.Next != "" {
+			parts = append(parts, i18n.T("skills.next", st.Next))
+		}
+	}
+	if st.Unspent > 0 {
+		parts = append(parts, i18n.T("skills.unspent", st.Unspent))
+	}
+	if n := len(st.Deviations); n > 0 {
+		parts = append(parts, i18n.T("skills.deviations", n))
+	}
+	return i18n.T("skills.label", strings.Join(parts, " | "))
+}
+
+// showSkillOrderDialog şampiyon için yetenek sırasını soran form açar
+func (mw *MainWindow) showSkillOrderDialog(champion string) {
+	if mw.service == nil {
+		return
+	}
+
+	entry := widget.NewEntry()
+	entry.SetPlaceHolder("Q > E > W")
+	if mw.lastState != nil && mw.lastState.Game.Skills.Order != nil {
+		entry.SetText(mw.lastState.Game.Skills.Order.String())
+	}
+
+	items := []*widget.FormItem{widget.NewFormItem(champion, entry)}
+	dialog.ShowForm(i18n.T("skills.title"), i18n.T("common.save"), i18n.T("common.cancel"), items, func(ok bool) {
+		if !ok {
+			return
+		}
+		order, err := lol.ParseSkillOrder(entry.Text)
+		if err == nil {
+			err = mw.service.SetSkillOrder(champion, order)
+		}
+		if err != nil {
+			dialog.ShowError(err, mw.window)
+		}
+	}, mw.window)
+}
@@ -0,0 +1,84 @@
+package gui
+
+import (
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"lol-helper/internal/i18n"
+	"lol-helper/internal/lcu"
+	"lol-helper/internal/lol"
+)
+
+// neutralPerspective izleyici modunda hiçbir oyuncunun seçilmediği tarafsız görünüm
+func neutralPerspective() string {
+	return i18n.T("spectator.neutral")
+}
+
+// perspectivePicker izleyici modunda AI koçunun bakış açısını seçtiren çubuk
+type perspectivePicker struct {
+	container fyne.CanvasObject
+	sel       *widget.Select
+
+	names   map[string]string // Seçici etiketi -> sihirdar adı
+	lastKey string
+}
+
+// newPerspectivePicker yeni bir bakış açısı seçici oluşturur; onChange seçilen sihirdar adıyla çağrılır
+func newPerspectivePicker(onChange func(summonerName string)) *perspectivePicker {
+	p := &perspectivePicker{names: make(map[string]string)}
+	p.sel = widget.NewSelect([]string{neutralPerspective()}, func(label string) {
+		onChange(p.names[label])
+	})
+	p.sel.PlaceHolder = neutralPerspective()
+	p.container = container.NewBorder(nil, nil, widget.NewLabel(i18n.T("spectator.label")), nil, p.sel)
+	p.container.Hide()
+	return p
+}
+
+// Update izleyici modunda seçiciyi gösterir ve oyuncu listesi değiştiyse seçenekleri yeniler
+func (p *perspectivePicker) Update(state *lol.GameState) {
+	if !state.Spectator {
+		if p.container.Visible() {
+			p.container.Hide()
+		}
+		return
+	}
+	if !p.container.Visible() {
+		p.container.Show()
+	}
+
+	key := playersKey(state.AllPlayers)
+	if key == p.lastKey {
+		return
+	}
+	p.lastKey = key
+
+	neutral := neutralPerspective()
+	names := map[string]string{neutral: ""}
+	options := []string{neutral}
+	for _, team := range []string{"ORDER", "CHAOS"} {
+		for _, pl := range state.AllPlayers {
+			if pl.Team != team {
+				continue
+			}
+			label := fmt.Sprintf("%s — %s (%s)", teamDisplayName(team), pl.ChampionName, pl.SummonerName)
+			names[label] = pl.SummonerName
+			options = append(options, label)
+		}
+	}
+	p.names = names
+	p.sel.Options = options
+	p.sel.Refresh()
+}
+
+func playersKey(players []lcu.LivePlayer) string {
+	names := make([]string, 0, len(players))
+	for _, pl := range players {
+		names = append(names, pl.SummonerName)
+	}
+	return strings.Join(names, ",")
+}
@@ -0,0 +1,131 @@
+package gui
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"lol-helper/internal/i18n"
+	"lol-helper/internal/lcu"
+)
+
+var _ fyne.SecondaryTappable = (*spellButton)(nil)
+
+// spellButton rakip sihirdar büyüsü düğmesi.
+// Sol tık: kullanıldı olarak işaretler ve mesajı panoya kopyalar (sayaç varsa sadece kopyalar).
+// Sağ tık: sayacı sıfırlar.
+type spellButton struct {
+	widget.Button
+
+	player string
+	slot   int
+	spell  string
+
+	onSecondary func()
+}
+
+func newSpellButton(player string, slot int, spell string) *spellButton {
+	b := &spellButton{player: player, slot: slot, spell: spell}
+	b.Text = spell
+	b.ExtendBaseWidget(b)
+	return b
+}
+
+// TappedSecondary sağ tık
+func (b *spellButton) TappedSecondary(_ *fyne.PointEvent) {
+	if b.onSecondary != nil {
+		b.onSecondary()
+	}
+}
+
+// createSpellCells oyuncunun iki sihirdar büyüsünü gösterir. Rakipler için
+// tıklanabilir sayaç düğmeleri, takım arkadaşları için düz etiket üretir.
+func (mw *MainWindow) createSpellCells(p lcu.LivePlayer, enemy bool) fyne.CanvasObject {
+	spells := []lcu.LiveSpell{p.SummonerSpells.SummonerSpellOne, p.SummonerSpells.SummonerSpellTwo}
+
+	if !enemy || mw.service == nil {
+		return container.NewHBox(
+			mw.fixedLabel(spells[0].DisplayName, 70, false),
+			mw.fixedLabel(spells[1].DisplayName, 70, false),
+		)
+	}
+
+	row := container.NewHBox()
+	for slot, spell := range spells {
+		b := newSpellButton(p.SummonerName, slot, spell.DisplayName)
+		b.OnTapped = func() { mw.onSpellTapped(b) }
+		b.onSecondary = func() {
+			mw.service.Spells().Clear(b.player, b.slot)
+			mw.refreshSpellButton(b)
+		}
+		mw.spellMu.Lock()
+		mw.spellButtons = append(mw.spellButtons, b)
+		mw.spellMu.Unlock()
+		row.Add(container.New(&fixedWidthLayout{width: 70}, b))
+	}
+	return row
+}
+
+// onSpellTapped büyüyü kullanıldı olarak işaretler ve takım mesajını panoya kopyalar
+func (mw *MainWindow) onSpellTapped(b *spellButton) {
+	spells := mw.service.Spells()
+	now := mw.clock.Now()
+
+	// Sayaç zaten çalışıyorsa tekrar işaretleme, sadece mesajı yeniden kopyala
+	timer, ok := spells.Timer(b.player, b.slot, now)
+	if !ok {
+		p, found := mw.findPlayer(b.player)
+		if !found {
+			return
+		}
+
+		var err error
+		timer, err = spells.MarkUsed(p, b.slot, now, mw.lastState.Game.KnownRuneIDs(p))
+		if err != nil {
+			mw.statusLabel.SetText(i18n.T("status.error", err))
+			return
+		}
+	}
+
+	mw.window.Clipboard().SetContent(timer.Callout())
+	mw.refreshSpellButton(b)
+}
+
+// refreshSpellButtons her saniye tüm büyü düğmelerinin sayaçlarını günceller
+func (mw *MainWindow) refreshSpellButtons() {
+	mw.spellMu.Lock()
+	buttons := append([]*spellButton(nil), mw.spellButtons...)
+	mw.spellMu.Unlock()
+
+	for _, b := range buttons {
+		mw.refreshSpellButton(b)
+	}
+}
+
+func (mw *MainWindow) refreshSpellButton(b *spellButton) {
+	text := b.spell
+	importance := widget.MediumImportance
+	if timer, ok := mw.service.Spells().Timer(b.player, b.slot, mw.clock.Now()); ok {
+		text = formatGameTime(timer.Remaining(mw.clock.Now()))
+		importance = widget.DangerImportance
+	}
+
+	if b.Text != text || b.Importance != importance {
+		b.Text = text
+		b.Importance = importance
+		b.Refresh()
+	}
+}
+
+// findPlayer son durumdaki güncel oyuncu verisini (item, rün) isimle bulur
+func (mw *MainWindow) findPlayer(name string) (lcu.LivePlayer, bool) {
+	if mw.lastState == nil {
+		return lcu.LivePlayer{}, false
+	}
+	for _, p := range mw.lastState.Game.AllPlayers {
+		if p.SummonerName == name {
+			return p, true
+		}
+	}
+	return lcu.LivePlayer{}, false
+}
@@ -0,0 +1,275 @@
+package gui
+
+import (
+	"fmt"
+	"image/color"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"lol-helper/internal/i18n"
+	"lol-helper/internal/lol"
+)
+
+var (
+	statColorA = color.RGBA{R: 90, G: 170, B: 230, A: 255}
+	statColorB = color.RGBA{R: 230, G: 110, B: 90, A: 255}
+)
+
+// statKeys istatistiklerin mesaj anahtarları
+var statKeys = map[lol.StatKey]string{
+	lol.StatAttackDamage:    "stat.attackDamage",
+	lol.StatAbilityPower:    "stat.abilityPower",
+	lol.StatArmor:           "stat.armor",
+	lol.StatMagicResist:     "stat.magicResist",
+	lol.StatAttackSpeed:     "stat.attackSpeed",
+	lol.StatAbilityHaste:    "stat.abilityHaste",
+	lol.StatLethality:       "stat.lethality",
+	lol.StatArmorPenPercent: "stat.armorPenPercent",
+	lol.StatMagicPenFlat:    "stat.magicPenFlat",
+	lol.StatMagicPenPercent: "stat.magicPenPercent",
+	lol.StatMaxHealth:       "stat.maxHealth",
+}
+
+// statName istatistiğin ekranda gösterilen adı
+func statName(key lol.StatKey) string {
+	return i18n.T(statKeys[key])
+}
+
+// currentGameOption karşılaştırma seçicisinde aktif oyunu temsil eden seçenek
+func currentGameOption() string {
+	return i18n.T("stats.currentGame")
+}
+
+// statsPanel yerel oyuncunun istatistik grafiği ve iki oyunun karşılaştırması
+type statsPanel struct {
+	container fyne.CanvasObject
+	chart     *lineChart
+	statSel   *widget.Select
+	gameASel  *widget.Select
+	gameBSel  *widget.Select
+	table     *fyne.Container
+
+	mu       sync.Mutex
+	recorder *lol.StatRecorder
+	current  *lol.StatRecord
+	stat     lol.StatKey
+	gameIDs  map[string]string // Seçici etiketi -> oyun ID'si
+	a, b     *lol.StatRecord   // Karşılaştırılan oyunlar (a boşsa aktif oyun)
+}
+
+// newStatsPanel yeni bir istatistik paneli oluşturur
+func newStatsPanel() *statsPanel {
+	p := &statsPanel{
+		stat:    lol.StatAttackDamage,
+		gameIDs: make(map[string]string),
+		table:   container.NewGridWithColumns(len(lol.StatComparisonMinutes) + 1),
+		chart: newLineChart(formatGameTime, func(v float64) string {
+			return fmt.Sprintf("%.0f", v)
+		}),
+	}
+
+	options := make([]string, 0, len(lol.StatKeys))
+	for _, key := range lol.StatKeys {
+		options = append(options, statName(key))
+	}
+	p.statSel = widget.NewSelect(options, func(name string) {
+		for key := range statKeys {
+			if statName(key) == name {
+				p.mu.Lock()
+				p.stat = key
+				p.mu.Unlock()
+			}
+		}
+		p.render()
+	})
+	p.statSel.SetSelected(statName(p.stat))
+
+	p.gameASel = widget.NewSelect(nil, func(string) { p.loadSelection() })
+	p.gameASel.PlaceHolder = currentGameOption()
+	p.gameBSel = widget.NewSelect(nil, func(string) { p.loadSelection() })
+	p.gameBSel.PlaceHolder = i18n.T("stats.compareGame")
+
+	refresh := widget.NewButton(i18n.T("stats.refresh"), p.refreshGames)
+
+	p.container = container.NewBorder(
+		container.NewVBox(
+			container.NewHBox(widget.NewLabel(i18n.T("stats.stat")), p.statSel),
+			container.NewGridWithColumns(3, p.gameASel, p.gameBSel, refresh),
+		),
+		container.NewVBox(
+			widget.NewSeparator(),
+			widget.NewLabelWithStyle(i18n.T("stats.comparison"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+			container.NewVScroll(p.table),
+		),
+		nil, nil,
+		p.chart,
+	)
+	return p
+}
+
+// SetRecorder kayıtlı oyunların okunacağı kaydediciyi ayarlar
+func (p *statsPanel) SetRecorder(recorder *lol.StatRecorder) {
+	p.mu.Lock()
+	p.recorder = recorder
+	p.mu.Unlock()
+	p.refreshGames()
+}
+
+// Update aktif oyunun kaydını günceller
+func (p *statsPanel) Update(rec *lol.StatRecord) {
+	p.mu.Lock()
+	championChanged := rec != nil && (p.current == nil || p.current.Champion != rec.Champion)
+	p.current = rec
+	p.mu.Unlock()
+
+	// Şampiyon değişince karşılaştırma listesi o şampiyonun oyunlarına daralır
+	if championChanged {
+		p.refreshGames()
+	}
+	p.render()
+}
+
+// refreshGames aynı şampiyonla oynanmış kayıtlı oyunları seçicilere doldurur
+func (p *statsPanel) refreshGames() {
+	p.mu.Lock()
+	recorder := p.recorder
+	champion := ""
+	if p.current != nil {
+		champion = p.current.Champion
+	}
+	p.mu.Unlock()
+	if recorder == nil {
+		return
+	}
+
+	games, err := recorder.List(champion)
+	if err != nil {
+		games = nil
+	}
+
+	ids := make(map[string]string, len(games))
+	options := []string{currentGameOption()}
+	for _, g := range games {
+		label := fmt.Sprintf("%s %s (%s)", g.StartedAt.Format("02.01 15:04"), g.Champion, formatGameTime(g.Duration))
+		ids[label] = g.GameID
+		options = append(options, label)
+	}
+
+	p.mu.Lock()
+	p.gameIDs = ids
+	p.mu.Unlock()
+
+	p.gameASel.Options = options
+	p.gameASel.Refresh()
+	p.gameBSel.Options = options[1:]
+	p.gameBSel.Refresh()
+}
+
+// loadSelection seçilen oyunları diskten okur
+func (p *statsPanel) loadSelection() {
+	p.mu.Lock()
+	recorder := p.recorder
+	idA, idB := p.gameIDs[p.gameASel.Selected], p.gameIDs[p.gameBSel.Selected]
+	p.mu.Unlock()
+
+	var a, b *lol.StatRecord
+	if recorder != nil {
+		if idA != "" {
+			a, _ = recorder.Load(idA)
+		}
+		if idB != "" {
+			b, _ = recorder.Load(idB)
+		}
+	}
+
+	p.mu.Lock()
+	p.a, p.b = a, b
+	p.mu.Unlock()
+	p.render()
+}
+
+// render grafiği ve karşılaştırma tablosunu çizer
+func (p *statsPanel) render() {
+	p.mu.Lock()
+	stat := p.stat
+	a, b := p.a, p.b
+	if a == nil {
+		a = p.current
+	}
+	p.mu.Unlock()
+
+	var series []chartSeries
+	if a != nil {
+		series = append(series, chartSeries{Name: recordLabel(a), Color: statColorA, Points: statPoints(a, stat)})
+	}
+	if b != nil {
+		series = append(series, chartSeries{Name: recordLabel(b), Color: statColorB, Points: statPoints(b, stat)})
+	}
+	p.chart.SetSeries(series)
+
+	p.table.Objects = p.comparisonRows(a, b)
+	p.table.Refresh()
+}
+
+// comparisonRows her istatistik için 10/15/20. dakika değerlerini (ve varsa farkı) üretir
+func (p *statsPanel) comparisonRows(a, b *lol.StatRecord) []fyne.CanvasObject {
+	if a == nil {
+		return nil
+	}
+
+	var comparisons []lol.StatComparison
+	if b != nil {
+		comparisons = lol.CompareStats(a, b)
+	} else {
+		comparisons = lol.CompareStats(a, &lol.StatRecord{})
+	}
+
+	rows := []fyne.CanvasObject{widget.NewLabelWithStyle("", fyne.TextAlignLeading, fyne.TextStyle{Bold: true})}
+	for _, minute := range lol.StatComparisonMinutes {
+		rows = append(rows, widget.NewLabelWithStyle(i18n.T("stats.minute", minute), fyne.TextAlignCenter, fyne.TextStyle{Bold: true}))
+	}
+
+	for _, key := range lol.StatKeys {
+		rows = append(rows, widget.NewLabel(statName(key)))
+		for _, c := range comparisons {
+			rows = append(rows, widget.NewLabelWithStyle(formatStatCell(c, key, b != nil), fyne.TextAlignCenter, fyne.TextStyle{}))
+		}
+	}
+	return rows
+}
+
+// formatStatCell tek bir tablo hücresi: "120" veya karşılaştırmada "120 / 135 (+15)"
+func formatStatCell(c lol.StatComparison, key lol.StatKey, compare bool) string {
+	if c.A == nil {
+		return "-"
+	}
+	if !compare {
+		return formatStatValue(c.A[key])
+	}
+	if c.B == nil {
+		return formatStatValue(c.A[key]) + " / -"
+	}
+	return fmt.Sprintf("%s / %s (%+.0f)", formatStatValue(c.A[key]), formatStatValue(c.B[key]), c.B[key]-c.A[key])
+}
+
+func formatStatValue(v float64) string {
+	if v != float64(int(v)) && v < 10 {
+		return fmt.Sprintf("%.2f", v) // Saldırı hızı gibi küçük ondalıklı değerler
+	}
+	return fmt.Sprintf("%.0f", v)
+}
+
+func statPoints(rec *lol.StatRecord, key lol.StatKey) []chartPoint {
+	points := make([]chartPoint, 0, len(rec.Samples))
+	for _, s := range rec.Samples {
+		points = append(points, chartPoint{X: s.GameTime, Y: s.Values[key]})
+	}
+	return points
+}
+
+func recordLabel(rec *lol.StatRecord) string {
+	return fmt.Sprintf("%s %s", rec.Champion, rec.StartedAt.Format("02.01 15:04"))
+}
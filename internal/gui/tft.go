@@ -0,0 +1,176 @@
+package gui
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"lol-helper/internal/appdir"
+	"lol-helper/internal/i18n"
+	"lol-helper/internal/lol"
+	"lol-helper/internal/staticdata"
+)
+
+// tftTraitPrefix maç geçmişindeki özellik adlarının set ön eki ("Set10_Pentakill")
+var tftTraitPrefix = regexp.MustCompile(`^(TFT)?Set\d+_`)
+
+// tftPanel TFT'de skor tablosunun yerine geçen lobi, sıralama geçmişi ve set özeti görünümü
+type tftPanel struct {
+	container fyne.CanvasObject
+	lobby     *widget.Label
+	summary   *widget.Label
+	history   *widget.Label
+	reference *widget.Label
+	search    *widget.Entry
+	ref       *staticdata.TFTReference
+
+	lastKey string
+}
+
+// newTFTPanel yeni bir TFT paneli oluşturur. Set özeti veri klasöründeki tft.json'dan
+// okunur; dosya yoksa gömülü özet oraya yazılır.
+func newTFTPanel() *tftPanel {
+	p := &tftPanel{
+		lobby:     widget.NewLabel(i18n.T("tft.lobbyWaiting")),
+		summary:   widget.NewLabel(""),
+		history:   widget.NewLabel(i18n.T("tft.historyWaiting")),
+		reference: widget.NewLabel(""),
+		search:    widget.NewEntry(),
+	}
+	p.history.Wrapping = fyne.TextWrapWord
+	p.reference.Wrapping = fyne.TextWrapWord
+
+	path := ""
+	if dataDir, err := appdir.Dir(); err == nil {
+		path = filepath.Join(dataDir, "tft.json")
+	}
+	ref, err := staticdata.LoadTFTReference(path)
+	if err != nil {
+		fyne.LogError("TFT set özeti yüklenemedi, gömülü özet kullanılıyor", err)
+		ref, _ = staticdata.LoadTFTReference("")
+	}
+	p.ref = ref
+
+	p.search.SetPlaceHolder(i18n.T("tft.search"))
+	p.search.OnChanged = func(query string) {
+		p.reference.SetText(formatTFTReference(p.ref, query))
+	}
+	p.reference.SetText(formatTFTReference(p.ref, ""))
+
+	left := container.NewBorder(
+		widget.NewCard("", i18n.T("tft.lobby"), p.lobby), nil, nil, nil,
+		widget.NewCard("", i18n.T("tft.history"), container.NewBorder(p.summary, nil, nil, nil, container.NewVScroll(p.history))),
+	)
+	right := widget.NewCard("", fmt.Sprintf("Set %d — %s", p.ref.Set, p.ref.Name),
+		container.NewBorder(p.search, nil, nil, nil, container.NewVScroll(p.reference)))
+	p.container = container.NewGridWithColumns(2, left, right)
+	p.container.Hide()
+	return p
+}
+
+// Update lobi veya geçmiş değiştiyse paneli yeniler
+func (p *tftPanel) Update(state *lol.TFTState) {
+	lobby := formatTFTLobby(state.Lobby)
+	history := formatTFTHistory(state.History)
+	key := lobby + "|" + history
+	if key == p.lastKey {
+		return
+	}
+	p.lastKey = key
+
+	p.lobby.SetText(lobby)
+	p.history.SetText(history)
+	if len(state.History) > 0 {
+		p.summary.SetText(i18n.T("tft.summary",
+			len(state.History), state.AveragePlacement, state.Top4Rate*100))
+	} else {
+		p.summary.SetText("")
+	}
+}
+
+// formatTFTLobby lobi oyuncularını satır satır listeler; yerel oyuncu işaretlenir
+func formatTFTLobby(lobby []lol.TFTPlayer) string {
+	if len(lobby) == 0 {
+		return i18n.T("tft.lobbyWaiting")
+	}
+	lines := make([]string, 0, len(lobby))
+	for i, p := range lobby {
+		line := fmt.Sprintf("%d. %s", i+1, p.Name)
+		if p.Local {
+			line += " " + i18n.T("team.us")
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatTFTHistory her oyunu "#3 · Seviye 8 · 34 dk · Pentakill 5, K/DA 3" biçiminde listeler
+func formatTFTHistory(history []lol.TFTPlacement) string {
+	if len(history) == 0 {
+		return i18n.T("tft.noHistory")
+	}
+	lines := make([]string, 0, len(history))
+	for _, h := range history {
+		parts := []string{
+			fmt.Sprintf("#%d", h.Placement),
+			i18n.T("tft.level", h.Level),
+			i18n.T("tft.minutes", h.Duration.Minutes()),
+		}
+		if h.GameType == "pairs" {
+			parts = append(parts, "Double Up")
+		}
+		if !h.PlayedAt.IsZero() {
+			parts = append(parts, h.PlayedAt.Format("02.01 15:04"))
+		}
+		var traits []string
+		for i, t := range h.Traits {
+			if i == 3 {
+				break
+			}
+			traits = append(traits, fmt.Sprintf("%s %d", tftTraitPrefix.ReplaceAllString(t.Name, ""), t.Units))
+		}
+		if len(traits) > 0 {
+			parts = append(parts, strings.Join(traits, ", "))
+		}
+		lines = append(lines, strings.Join(parts, " · "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatTFTReference sorguyla eşleşen özellikleri aktif olduğu birim sayıları ve
+// birimleriyle listeler. Birim adı eşleşirse o birimin özellikleri de listelenir.
+func formatTFTReference(ref *staticdata.TFTReference, query string) string {
+	query = strings.ToLower(strings.TrimSpace(query))
+	var lines []string
+	for _, t := range ref.Traits {
+		match := query == "" || strings.Contains(strings.ToLower(t.Name), query)
+		var units []string
+		for _, u := range ref.UnitsWithTrait(t.Name) {
+			if query != "" && strings.Contains(strings.ToLower(u.Name), query) {
+				match = true
+			}
+			units = append(units, fmt.Sprintf("%s (%d)", u.Name, u.Cost))
+		}
+		if !match {
+			continue
+		}
+		breakpoints := make([]string, 0, len(t.Breakpoints))
+		for _, b := range t.Breakpoints {
+			breakpoints = append(breakpoints, strconv.Itoa(b))
+		}
+		lines = append(lines,
+			fmt.Sprintf("%s [%s]: %s", t.Name, strings.Join(breakpoints, "/"), t.Description),
+			"    "+strings.Join(units, ", "),
+		)
+	}
+	if len(lines) == 0 {
+		return i18n.T("tft.noMatch")
+	}
+	return strings.Join(lines, "\n")
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"image/color"
 	"strings"
+	"sync"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/app"
@@ -12,26 +14,63 @@ import (
 	"fyne.io/fyne/v2/dialog"
 	"fyne.io/fyne/v2/widget"
 
+	"lol-helper/internal/appdir"
+	"lol-helper/internal/assets"
+	"lol-helper/internal/i18n"
 	"lol-helper/internal/lcu"
 	"lol-helper/internal/lol"
+	"lol-helper/internal/staticdata"
 )
 
 // MainWindow ana pencere yapısı
 type MainWindow struct {
-	app         fyne.App
-	window      fyne.Window
-	service     *lol.Service
-	itemManager *ItemManager
+	app     fyne.App
+	window  fyne.Window
+	service *lol.Service
+	store   *staticdata.Store // Yama başına önbelleklenen Data Dragon verisi
+	assets  *assets.Service   // İkonlar; diske ve belleğe önbelleklenir
 
 	// Cache
-	imageCache      map[int]fyne.Resource
 	lastPlayerNames string // Player isimlerini cache'le
 	lastAIItems     string
 	playersLoaded   bool // İlk yükleme yapıldı mı?
+	lastState       *lol.HelperState
+	clock           *gameClock
 
 	// UI Components
-	statusLabel *widget.Label
-	phaseLabel  *widget.Label
+	statusLabel    *widget.Label
+	phaseLabel     *widget.Label
+	objectiveStrip *objectiveStrip
+	goldPanel      *goldPanel
+	statsPanel     *statsPanel
+	skillBar       *skillBar
+	perspective    *perspectivePicker
+	feed           *notificationFeed
+	composition    *compositionPanel
+	benchBar       *benchBar
+	arenaBar       *arenaBar
+	tft            *tftPanel
+	purchases      *purchasePanel
+	centerTabs     *container.AppTabs
+	goldTab        *container.TabItem // Arena'da sekmelerden çıkarılır
+	stopChan       chan struct{}
+
+	// Sihirdar büyüsü sayaç düğmeleri (satırlar yeniden kurulunca yenilenir)
+	spellButtons []*spellButton
+	spellMu      sync.Mutex
+
+	// Bildirim gelince vurgulanan satır arka planları (oyuncu adı -> arka plan),
+	// hedef derecesine göre taban renkleri ve hedef farkı etiketleri
+	rowBackgrounds map[string]*canvas.Rectangle
+	rowBaseColors  map[string]color.Color
+	benchLabels    map[string]*widget.Label
+	portraits      map[string]*championPortrait
+	rowMu          sync.Mutex
+	showBenchmarks bool // Hedef farkı sütunu (ARAM gibi koridorsuz modlarda kapalı)
+
+	// TFT'de tft paneliyle yer değiştiren skor tablosu görünümü ve AI kartı
+	classicView fyne.CanvasObject
+	aiCard      fyne.CanvasObject
 
 	// Team Containers
 	teamOrderContainer *fyne.Container
@@ -48,14 +87,34 @@ func NewMainWindow() *MainWindow {
 	a := app.New()
 	a.Settings().SetTheme(&LoLTheme{})
 
-	w := a.NewWindow("LoL Helper AI")
+	w := a.NewWindow(i18n.T("app.title"))
 	w.Resize(fyne.NewSize(1200, 800))
 
+	// Önbellek klasörü yoksa Data Dragon verisi her açılışta indirilir
+	ddragonDir, err := appdir.Dir("ddragon")
+	if err != nil {
+		fyne.LogError("Data Dragon önbelleği kullanılamıyor", err)
+	}
+	store := staticdata.NewStore(ddragonDir, i18n.Current().DDragon())
+
+	// İkon önbelleği kullanılamazsa ikonlar sadece bellekte tutulur
+	iconDir, err := appdir.Dir("icons")
+	if err != nil {
+		fyne.LogError("İkon önbelleği kullanılamıyor", err)
+	}
+
 	mw := &MainWindow{
-		app:         a,
-		window:      w,
-		itemManager: NewItemManager(),
-		imageCache:  make(map[int]fyne.Resource),
+		app:            a,
+		window:         w,
+		store:          store,
+		assets:         assets.NewService(iconDir, assets.DefaultWorkers, assets.DefaultCapacity),
+		clock:          &gameClock{},
+		rowBackgrounds: make(map[string]*canvas.Rectangle),
+		rowBaseColors:  make(map[string]color.Color),
+		benchLabels:    make(map[string]*widget.Label),
+		portraits:      make(map[string]*championPortrait),
+		showBenchmarks: true,
+		stopChan:       make(chan struct{}),
 	}
 
 	mw.setupUI()
@@ -65,14 +124,23 @@ func NewMainWindow() *MainWindow {
 // setupUI arayüz bileşenlerini oluşturur
 func (mw *MainWindow) setupUI() {
 	// Status Section
-	mw.statusLabel = widget.NewLabel("Durum: Başlatılıyor...")
-	mw.phaseLabel = widget.NewLabel("Oyun Fazı: -")
+	mw.statusLabel = widget.NewLabel(i18n.T("status.starting"))
+	mw.phaseLabel = widget.NewLabel(i18n.T("phase.label", "-"))
+	mw.objectiveStrip = newObjectiveStrip(mw.app, mw.clock)
+	mw.skillBar = newSkillBar(mw.showSkillOrderDialog)
+	mw.benchBar = newBenchBar()
+	mw.arenaBar = newArenaBar(mw.showAugmentDialog)
+	mw.perspective = newPerspectivePicker(func(name string) {
+		if mw.service != nil {
+			mw.service.SetPerspective(name)
+		}
+	})
 
 	// AI Suggestion Section
-	mw.suggestionLabel = widget.NewLabel("Öneri: Bekleniyor...")
+	mw.suggestionLabel = widget.NewLabel(i18n.T("ai.suggestion.waiting"))
 	mw.suggestionLabel.Wrapping = fyne.TextWrapWord
 
-	mw.strategyLabel = widget.NewLabel("Strateji: -")
+	mw.strategyLabel = widget.NewLabel(i18n.T("ai.strategy.empty"))
 	mw.strategyLabel.Wrapping = fyne.TextWrapWord
 
 	mw.aiItemsContainer = container.NewHBox()
@@ -87,28 +155,49 @@ func (mw *MainWindow) setupUI() {
 		container.NewPadded(mw.teamChaosContainer),
 	)
 
+	mw.goldPanel = newGoldPanel()
+	mw.statsPanel = newStatsPanel()
+	mw.feed = newNotificationFeed()
+	mw.composition = newCompositionPanel()
+	mw.tft = newTFTPanel()
+	mw.purchases = newPurchasePanel()
+
+	// Center Tabs
+	mw.goldTab = container.NewTabItem(i18n.T("tab.gold"), mw.goldPanel.container)
+	mw.centerTabs = container.NewAppTabs(
+		container.NewTabItem(i18n.T("tab.scoreboard"), teamsSplit),
+		mw.goldTab,
+		container.NewTabItem(i18n.T("tab.stats"), mw.statsPanel.container),
+	)
+
 	// Top Info
 	topInfo := container.NewVBox(
-		mw.statusLabel,
+		container.NewBorder(nil, nil, nil, widget.NewButton(i18n.T("report.title"), mw.showReportDialog), mw.statusLabel),
 		mw.phaseLabel,
+		mw.objectiveStrip.container,
+		mw.skillBar.container,
+		mw.benchBar.container,
+		mw.arenaBar.container,
+		mw.perspective.container,
 	)
 
 	// Bottom AI - Professional Layout
-	aiHeader := widget.NewLabelWithStyle("AI KOÇ ANALİZİ", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
+	aiHeader := widget.NewLabelWithStyle(i18n.T("ai.header"), fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
 
 	// Left: Strategy & Suggestion
 	aiTextContent := container.NewVBox(
-		widget.NewLabelWithStyle("Strateji", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		widget.NewLabelWithStyle(i18n.T("ai.strategy"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
 		mw.strategyLabel,
 		widget.NewSeparator(),
-		widget.NewLabelWithStyle("Öneri", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		widget.NewLabelWithStyle(i18n.T("ai.suggestion"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
 		mw.suggestionLabel,
 	)
 
 	// Right: Recommended Items
 	aiItemsContent := container.NewVBox(
-		widget.NewLabelWithStyle("Önerilen Eşyalar", fyne.TextAlignCenter, fyne.TextStyle{Bold: true}),
+		widget.NewLabelWithStyle(i18n.T("ai.items"), fyne.TextAlignCenter, fyne.TextStyle{Bold: true}),
 		container.NewCenter(mw.aiItemsContainer),
+		mw.purchases.container,
 	)
 
 	// Split AI Section
@@ -123,12 +212,19 @@ func (mw *MainWindow) setupUI() {
 		aiSplit,
 	))
 
+	mw.aiCard = bottomAI
+	mw.classicView = container.NewBorder(
+		nil, nil,
+		nil, container.NewBorder(mw.composition.container, nil, nil, nil, mw.feed.container),
+		mw.centerTabs,
+	)
+
 	// Main Layout
 	content := container.NewBorder(
 		topInfo,
 		bottomAI,
 		nil, nil,
-		teamsSplit,
+		container.NewStack(mw.classicView, mw.tft.container),
 	)
 
 	mw.window.SetContent(content)
@@ -139,65 +235,142 @@ func (mw *MainWindow) Start() {
 	// Servisi başlat
 	service, err := lol.NewService(mw.UpdateUI)
 	if err != nil {
-		mw.statusLabel.SetText(fmt.Sprintf("Hata: %v", err))
+		mw.statusLabel.SetText(i18n.T("status.error", err))
 	} else {
 		mw.service = service
+		mw.service.SetStaticData(mw.store)
+		mw.statsPanel.SetRecorder(mw.service.Stats())
 		mw.service.Start()
 	}
 
+	// Servis LCU'ya bağlanınca istemcinin yamasına geçer; o zamana kadar en yeni (veya önbellekteki) yama
+	go func() {
+		if _, err := mw.store.Refresh(""); err != nil {
+			fyne.LogError("Data Dragon verisi yüklenemedi", err)
+		}
+	}()
+	go mw.tickLoop()
+
 	mw.window.ShowAndRun()
+	close(mw.stopChan)
 
 	// Kapanırken servisi durdur
 	if mw.service != nil {
 		mw.service.Stop()
 	}
+	mw.assets.Close()
+}
+
+// tickLoop saniyelik sayaçları (hedefler, sihirdar büyüleri) pencere kapanana kadar yeniler
+func (mw *MainWindow) tickLoop() {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-mw.stopChan:
+			return
+		case <-ticker.C:
+			mw.objectiveStrip.render()
+			mw.refreshSpellButtons()
+		}
+	}
 }
 
 // UpdateUI arayüzü günceller (thread-safe)
 func (mw *MainWindow) UpdateUI(state *lol.HelperState) {
+	mw.lastState = state
+	mw.clock.Set(float64(state.Game.GameTime), state.Game.Phase == "InProgress")
+
 	if state.Error != nil {
-		mw.statusLabel.SetText(fmt.Sprintf("Hata: %v", state.Error))
+		mw.statusLabel.SetText(i18n.T("status.error", state.Error))
+	} else if state.Game.IsConnected && state.Game.Patch != "" {
+		mw.statusLabel.SetText(i18n.T("status.connectedPatch", state.Game.Patch))
 	} else if state.Game.IsConnected {
-		mw.statusLabel.SetText("Durum: Bağlı")
+		mw.statusLabel.SetText(i18n.T("status.connected"))
 	} else {
-		mw.statusLabel.SetText("Durum: Bağlantı Bekleniyor...")
+		mw.statusLabel.SetText(i18n.T("status.waiting"))
 	}
 
-	mw.phaseLabel.SetText(fmt.Sprintf("Oyun Fazı: %s", state.Game.Phase))
+	phase := state.Game.Phase
+	if state.Game.Mode != "" && state.Game.Mode != lol.ModeClassic {
+		phase += " · " + state.Game.Mode.Label()
+	}
+	if state.Game.Spectator {
+		phase += " " + i18n.T("phase.spectator")
+	}
+	mw.phaseLabel.SetText(i18n.T("phase.label", phase))
+	mw.perspective.Update(state.Game)
+	mw.benchBar.Update(state.Game, mw.store.Current())
+	mw.arenaBar.Update(state.Game)
+	mw.composition.Update(state.Game.Composition)
+
+	// Yetenek sırası sadece kendi oyunumuzda anlamlı
+	if state.Game.Spectator || state.Game.Mode == lol.ModeTFT {
+		mw.skillBar.container.Hide()
+	} else {
+		mw.skillBar.container.Show()
+	}
 
 	if state.Recommendation != nil {
 		mw.suggestionLabel.SetText(state.Recommendation.Suggestion)
 		mw.strategyLabel.SetText(state.Recommendation.Strategy)
 
-		// Update AI Items - only if changed
-		newAIItems := strings.Join(state.Recommendation.NextItems, ",")
+		// AI'ın önerdiği itemler; tanınmayan adlar yanlış ikonla değil uyarıyla gösterilir
+		newAIItems := fmt.Sprintf("%v", state.Game.SuggestedItems)
 		if newAIItems != mw.lastAIItems {
 			mw.lastAIItems = newAIItems
 			mw.aiItemsContainer.Objects = nil
-			for _, itemName := range state.Recommendation.NextItems {
-				itemID := mw.itemManager.GetItemID(itemName)
-				if itemID != 0 {
-					img := mw.createItemImage(itemID)
-					itemContainer := container.NewVBox(
-						img,
-						widget.NewLabelWithStyle(itemName, fyne.TextAlignCenter, fyne.TextStyle{}),
-					)
-					mw.aiItemsContainer.Add(itemContainer)
-				} else {
-					mw.aiItemsContainer.Add(widget.NewLabel(itemName))
-				}
+			for _, suggestion := range state.Game.SuggestedItems {
+				mw.aiItemsContainer.Add(mw.suggestedItemView(suggestion))
 			}
 			mw.aiItemsContainer.Refresh()
 		}
 	}
+	mw.purchases.Update(state.Game.Purchases)
+
+	// ARAM ve Arena'da ejderha, baron ve herald yok
+	if state.Game.Mode.HasObjectives() {
+		mw.objectiveStrip.container.Show()
+		mw.objectiveStrip.Update(state.Game.Objectives, state.Game.GameTime)
+	} else {
+		mw.objectiveStrip.container.Hide()
+	}
+	// Arena'da iki takım yerine dört ikili var; ORDER/CHAOS altın farkı anlamsız
+	mw.showGoldTab(state.Game.Mode != lol.ModeArena)
+	mw.goldPanel.Update(state.Game.GoldEstimate)
+	mw.statsPanel.Update(state.Game.Stats)
+	mw.skillBar.Update(state.Game.Skills)
+
+	// TFT'de skor tablosu ve AI koçu yerine lobi, geçmiş ve set özeti gösterilir
+	if state.Game.TFT != nil {
+		mw.tft.Update(state.Game.TFT)
+		mw.classicView.Hide()
+		mw.aiCard.Hide()
+		mw.tft.container.Show()
+		return
+	}
+	mw.tft.container.Hide()
+	mw.classicView.Show()
+	mw.aiCard.Show()
 
 	// Update Players
-	mw.updatePlayerLists(state.Game.AllPlayers)
+	mw.updatePlayerLists(state.Game)
+	mw.updateBenchmarks(state.Game.Benchmarks)
+	mw.updatePortraits(state.Game.AllPlayers)
+
+	// Bildirimler satırlar kurulduktan sonra işlenir ki vurgu yeni satıra uygulansın
+	for _, n := range mw.feed.Update(state.Game.Notifications, state.Game.LocalTeam) {
+		mw.highlightPlayer(n.Player)
+	}
 }
 
-func (mw *MainWindow) updatePlayerLists(players []lcu.LivePlayer) {
-	// Player isimlerini string olarak oluştur
-	var currentPlayerNames string
+func (mw *MainWindow) updatePlayerLists(game *lol.GameState) {
+	players, localTeam, spectator, mode := game.AllPlayers, game.LocalTeam, game.Spectator, game.Mode
+
+	// Player isimlerini string olarak oluştur (takım bilgisi değişince rakip düğmeleri,
+	// yama yüklenince ikonlar da değişir)
+	currentPlayerNames := fmt.Sprintf("%s|%t|%s|%s|%s|", localTeam, spectator, mode, arenaLayoutKey(game.Arena), mw.store.Version())
 	for _, p := range players {
 		currentPlayerNames += p.SummonerName + ","
 	}
@@ -215,13 +388,36 @@ func (mw *MainWindow) updatePlayerLists(players []lcu.LivePlayer) {
 	// İlk kez yükleme veya gerçekten değişiklik var
 	mw.lastPlayerNames = currentPlayerNames
 	mw.playersLoaded = true
+	// Koridorsuz modlarda rol hedefi sütunu gösterilmez
+	mw.showBenchmarks = mode.HasLanes()
 
 	// Pre-build all elements first, then update containers atomically
 	orderPlayers := make([]fyne.CanvasObject, 0, 5)
 	chaosPlayers := make([]fyne.CanvasObject, 0, 5)
 
+	mw.spellMu.Lock()
+	mw.spellButtons = nil
+	mw.spellMu.Unlock()
+
+	mw.rowMu.Lock()
+	mw.rowBackgrounds = make(map[string]*canvas.Rectangle)
+	mw.rowBaseColors = make(map[string]color.Color)
+	mw.benchLabels = make(map[string]*widget.Label)
+	mw.portraits = make(map[string]*championPortrait)
+	mw.rowMu.Unlock()
+
+	if game.Arena != nil {
+		mw.buildArenaLists(players, game.Arena, spectator)
+		return
+	}
+
 	for _, p := range players {
-		card := mw.createPlayerRow(p)
+		// Tarafsız izleyici görünümünde iki takım simetriktir, büyü sayaçları herkes için açılır
+		enemy := localTeam != "" && p.Team != localTeam
+		if spectator && localTeam == "" {
+			enemy = true
+		}
+		card := mw.createPlayerRow(p, enemy)
 		if p.Team == "ORDER" {
 			orderPlayers = append(orderPlayers, card)
 		} else {
@@ -231,13 +427,13 @@ func (mw *MainWindow) updatePlayerLists(players []lcu.LivePlayer) {
 
 	// Clear and rebuild atomically
 	mw.teamOrderContainer.Objects = []fyne.CanvasObject{
-		widget.NewLabelWithStyle("ORDER TEAM (MAVİ)", fyne.TextAlignCenter, fyne.TextStyle{Bold: true}),
+		widget.NewLabelWithStyle(i18n.T("team.order"), fyne.TextAlignCenter, fyne.TextStyle{Bold: true}),
 		mw.createTableHeader(),
 	}
 	mw.teamOrderContainer.Objects = append(mw.teamOrderContainer.Objects, orderPlayers...)
 
 	mw.teamChaosContainer.Objects = []fyne.CanvasObject{
-		widget.NewLabelWithStyle("CHAOS TEAM (KIRMIZI)", fyne.TextAlignCenter, fyne.TextStyle{Bold: true}),
+		widget.NewLabelWithStyle(i18n.T("team.chaos"), fyne.TextAlignCenter, fyne.TextStyle{Bold: true}),
 		mw.createTableHeader(),
 	}
 	mw.teamChaosContainer.Objects = append(mw.teamChaosContainer.Objects, chaosPlayers...)
@@ -247,18 +443,23 @@ func (mw *MainWindow) updatePlayerLists(players []lcu.LivePlayer) {
 }
 
 func (mw *MainWindow) createTableHeader() fyne.CanvasObject {
-	return container.NewHBox(
-		mw.fixedLabel("Şampiyon", 120, true),
-		mw.fixedLabel("Sihirdar", 120, true),
-		mw.fixedLabel("KDA", 100, true),
-		mw.fixedLabel("CS", 50, true),
-		widget.NewLabelWithStyle("İtemler", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+	header := container.NewHBox(
+		mw.fixedLabel(i18n.T("table.champion"), championCellWidth, true),
+		mw.fixedLabel(i18n.T("table.summoner"), 120, true),
+		mw.fixedLabel(i18n.T("table.kda"), 100, true),
+		mw.fixedLabel(i18n.T("table.cs"), 50, true),
+		mw.fixedLabel(i18n.T("table.spells"), 140, true),
 	)
+	if mw.showBenchmarks {
+		header.Add(mw.fixedLabel(i18n.T("table.benchmark"), 170, true))
+	}
+	header.Add(widget.NewLabelWithStyle(i18n.T("table.items"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true}))
+	return header
 }
 
-func (mw *MainWindow) createPlayerRow(p lcu.LivePlayer) fyne.CanvasObject {
+func (mw *MainWindow) createPlayerRow(p lcu.LivePlayer, enemy bool) fyne.CanvasObject {
 	// Columns
-	champLabel := mw.fixedLabel(p.ChampionName, 120, false)
+	champCell := container.New(&fixedWidthLayout{width: championCellWidth}, mw.championCell(p))
 	nameLabel := mw.fixedLabel(p.SummonerName, 120, false)
 	kdaLabel := mw.fixedLabel(fmt.Sprintf("%d/%d/%d", p.Scores.Kills, p.Scores.Deaths, p.Scores.Assists), 100, false)
 	csLabel := mw.fixedLabel(fmt.Sprintf("%d", p.Scores.CreepScore), 50, false)
@@ -267,28 +468,30 @@ func (mw *MainWindow) createPlayerRow(p lcu.LivePlayer) fyne.CanvasObject {
 	itemsRow := container.NewHBox()
 	for _, item := range p.Items {
 		if item.ItemID != 0 {
-			itemImg := mw.createItemImage(item.ItemID)
+			itemImg := mw.itemIcon(item.ItemID)
 			itemsRow.Add(itemImg)
 		}
 	}
 	// Fill empty slots
 	for len(itemsRow.Objects) < 6 {
-		emptySlot := canvas.NewRectangle(color.RGBA{R: 20, G: 20, B: 20, A: 255})
-		emptySlot.SetMinSize(fyne.NewSize(32, 32))
-		itemsRow.Add(emptySlot)
+		itemsRow.Add(emptyIcon(itemIconSize))
 	}
 
 	// Row Content
 	content := container.NewHBox(
-		champLabel,
+		champCell,
 		nameLabel,
 		kdaLabel,
 		csLabel,
-		itemsRow,
+		mw.createSpellCells(p, enemy),
 	)
+	if mw.showBenchmarks {
+		content.Add(container.New(&fixedWidthLayout{width: 170}, mw.newBenchmarkLabel(p.SummonerName)))
+	}
+	content.Add(itemsRow)
 
-	// Clickable Wrapper
-	return NewClickableRow(content, func() {
+	// Clickable Wrapper (arka plan bildirim vurgusu için)
+	return NewClickableRow(container.NewStack(mw.newRowBackground(p.SummonerName), content), func() {
 		mw.showPlayerDetail(p)
 	})
 }
@@ -347,10 +550,27 @@ func (c *ClickableRow) Tapped(_ *fyne.PointEvent) {
 
 func (mw *MainWindow) showPlayerDetail(p lcu.LivePlayer) {
 	// Detailed Stats
-	stats := fmt.Sprintf("Seviye: %d\nAltın: %.0f\nKDA: %d/%d/%d\nCS: %d\nWard: %.1f",
-		p.Level, 0.0, // Gold might not be available for enemies usually, but struct has it? LiveActivePlayer has it. LivePlayer might not.
+	// Live Client rakip altınını vermez, envanter değerini gösteriyoruz
+	itemValue := 0
+	if mw.lastState != nil {
+		itemValue = mw.lastState.Game.GoldEstimate.PlayerValues[p.SummonerName]
+	}
+	stats := i18n.T("detail.stats",
+		p.Level, itemValue,
 		p.Scores.Kills, p.Scores.Deaths, p.Scores.Assists, p.Scores.CreepScore, p.Scores.WardScore)
 
+	benchmarkStr := i18n.T("detail.noBenchmark")
+	if mw.lastState != nil && mw.lastState.Game.Mode == lol.ModeARAM {
+		benchmarkStr = i18n.T("detail.noBenchmarkARAM")
+		if ch, ok := lol.LiveChampion(mw.store.Current(), p); ok && ch.ARAM != nil {
+			benchmarkStr += "\n" + i18n.T("detail.aramBalance", formatARAMBalance(*ch.ARAM))
+		}
+	} else if mw.lastState != nil && mw.service != nil {
+		if r, ok := mw.lastState.Game.Benchmarks[p.SummonerName]; ok {
+			benchmarkStr = formatBenchmarkDetail(r, mw.service.BenchmarkTier())
+		}
+	}
+
 	// Items
 	var itemNames []string
 	for _, item := range p.Items {
@@ -360,53 +580,37 @@ func (mw *MainWindow) showPlayerDetail(p lcu.LivePlayer) {
 	}
 	itemsStr := strings.Join(itemNames, "\n")
 
-	// Runes
-	runesStr := fmt.Sprintf("Keystone: %s\nPrimary: %s\nSecondary: %s",
-		p.Runes.Keystone.DisplayName, p.Runes.PrimaryRuneTree.DisplayName, p.Runes.SecondaryRuneTree.DisplayName)
-
 	content := container.NewVBox(
 		widget.NewLabelWithStyle(fmt.Sprintf("%s - %s", p.SummonerName, p.ChampionName), fyne.TextAlignCenter, fyne.TextStyle{Bold: true}),
 		widget.NewSeparator(),
-		widget.NewLabelWithStyle("İstatistikler", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		widget.NewLabelWithStyle(i18n.T("detail.stats.title"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
 		widget.NewLabel(stats),
 		widget.NewSeparator(),
-		widget.NewLabelWithStyle("İtemler", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		widget.NewLabelWithStyle(i18n.T("detail.benchmarks.title"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		widget.NewLabel(benchmarkStr),
+		widget.NewSeparator(),
+		widget.NewLabelWithStyle(i18n.T("detail.items.title"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
 		widget.NewLabel(itemsStr),
 		widget.NewSeparator(),
-		widget.NewLabelWithStyle("Rünler", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
-		widget.NewLabel(runesStr),
+		widget.NewLabelWithStyle(i18n.T("detail.runes.title"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		mw.runePageView(p),
 	)
 
-	d := dialog.NewCustom("Oyuncu Detayı", "Kapat", container.NewVScroll(content), mw.window)
+	d := dialog.NewCustom(i18n.T("detail.title"), i18n.T("common.close"), container.NewVScroll(content), mw.window)
 	d.Resize(fyne.NewSize(400, 500))
 	d.Show()
 }
 
-// createItemImage creates an item icon from DDragon
-func (mw *MainWindow) createItemImage(itemID int) fyne.CanvasObject {
-	// Check cache first
-	if res, ok := mw.imageCache[itemID]; ok {
-		img := canvas.NewImageFromResource(res)
-		img.FillMode = canvas.ImageFillContain
-		img.SetMinSize(fyne.NewSize(32, 32))
-		return img
-	}
-
-	// Data Dragon item icon URL
-	itemURL := fmt.Sprintf("https://ddragon.leagueoflegends.com/cdn/14.1.1/img/item/%d.png", itemID)
-
-	// Load resource (blocking but cached next time)
-	res, err := fyne.LoadResourceFromURLString(itemURL)
-	if err == nil {
-		mw.imageCache[itemID] = res
-		img := canvas.NewImageFromResource(res)
-		img.FillMode = canvas.ImageFillContain
-		img.SetMinSize(fyne.NewSize(32, 32))
-		return img
-	}
+var (
+	// itemPlaceholderColor yüklenemeyen ikonların yerine çizilen kutunun rengi
+	itemPlaceholderColor = color.RGBA{R: 50, G: 100, B: 150, A: 255}
+	// emptySlotColor boş item yuvası ve bilinmeyen ikonların rengi
+	emptySlotColor = color.RGBA{R: 20, G: 20, B: 20, A: 255}
+)
 
-	// Fallback
-	rect := canvas.NewRectangle(color.RGBA{R: 50, G: 100, B: 150, A: 255})
+// itemPlaceholder ikon yüklenemediğinde gösterilen kutu
+func itemPlaceholder() fyne.CanvasObject {
+	rect := canvas.NewRectangle(itemPlaceholderColor)
 	rect.SetMinSize(fyne.NewSize(32, 32))
 	return rect
 }
@@ -0,0 +1,126 @@
+// Package i18n arayüz metinlerini seçili dile göre çevirir. Mesajlar dil başına
+// locales/<dil>.json dosyalarında anahtar -> metin olarak tutulur; seçili dilde
+// olmayan anahtarlar İngilizceden, orada da yoksa anahtarın kendisiyle gösterilir.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+//go:embed locales/*.json
+var localeFiles embed.FS
+
+// Locale arayüz dili
+type Locale string
+
+const (
+	Turkish Locale = "tr"
+	English Locale = "en"
+
+	// Fallback seçili dilde olmayan anahtarların arandığı dil
+	Fallback = English
+	// Default dil ayarı yapılmamışsa kullanılan dil
+	Default = Turkish
+)
+
+// locales desteklenen diller ve Data Dragon / AI karşılıkları
+var locales = map[Locale]struct {
+	ddragon  string
+	language string
+}{
+	Turkish: {"tr_TR", "Turkish"},
+	English: {"en_US", "English"},
+}
+
+var (
+	mu       sync.RWMutex
+	current  = Default
+	catalogs map[Locale]map[string]string
+	loadOnce sync.Once
+)
+
+// Parse "tr", "tr_TR", "en-US" gibi değerleri desteklenen bir dile çevirir.
+// Boş veya desteklenmeyen değerlerde Default döner.
+func Parse(value string) Locale {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if i := strings.IndexAny(value, "_-"); i >= 0 {
+		value = value[:i]
+	}
+	if _, ok := locales[Locale(value)]; ok {
+		return Locale(value)
+	}
+	return Default
+}
+
+// Locales desteklenen dilleri döner
+func Locales() []Locale {
+	return []Locale{Turkish, English}
+}
+
+// SetLocale arayüz dilini ayarlar
+func SetLocale(l Locale) {
+	if _, ok := locales[l]; !ok {
+		l = Default
+	}
+	mu.Lock()
+	current = l
+	mu.Unlock()
+}
+
+// Current seçili arayüz dilini döner
+func Current() Locale {
+	mu.RLock()
+	defer mu.RUnlock()
+	return current
+}
+
+// DDragon dilin Data Dragon karşılığı ("tr_TR")
+func (l Locale) DDragon() string {
+	return locales[l].ddragon
+}
+
+// Language dilin AI istemlerinde kullanılan İngilizce adı ("Turkish")
+func (l Locale) Language() string {
+	return locales[l].language
+}
+
+// T anahtarın seçili dildeki metnini döner; args verilirse metin fmt biçimi olarak kullanılır
+func T(key string, args ...any) string {
+	msg := lookup(Current(), key)
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
+
+func lookup(l Locale, key string) string {
+	loadOnce.Do(load)
+	if msg, ok := catalogs[l][key]; ok {
+		return msg
+	}
+	if msg, ok := catalogs[Fallback][key]; ok {
+		return msg
+	}
+	return key
+}
+
+// load gömülü mesaj dosyalarını okur. Dosyalar derlemeyle geldiği için
+// bozuk bir dosya programlama hatasıdır.
+func load() {
+	catalogs = make(map[Locale]map[string]string, len(locales))
+	for l := range locales {
+		data, err := localeFiles.ReadFile("locales/" + string(l) + ".json")
+		if err != nil {
+			panic(fmt.Sprintf("i18n: %s mesaj dosyası yok: %v", l, err))
+		}
+		var msgs map[string]string
+		if err := json.Unmarshal(data, &msgs); err != nil {
+			panic(fmt.Sprintf("i18n: %s mesaj dosyası geçersiz: %v", l, err))
+		}
+		catalogs[l] = msgs
+	}
+}
@@ -1,6 +1,7 @@
 package lcu
 
 import (
+	"bytes"
 	"crypto/tls"
 	"encoding/base64"
 	"encoding/json"
@@ -108,12 +109,26 @@ func (c *Client) IsConnected() bool {
 
 // makeRequest LCU API'sine istek yapar
 func (c *Client) makeRequest(method, endpoint string) ([]byte, error) {
+	return c.doRequest(method, endpoint, nil)
+}
+
+// doRequest LCU API'sine opsiyonel JSON gövdesiyle istek yapar
+func (c *Client) doRequest(method, endpoint string, body any) ([]byte, error) {
 	if !c.connected {
 		return nil, fmt.Errorf("LCU'ya bağlı değil")
 	}
 
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(payload)
+	}
+
 	url := c.baseURL + endpoint
-	req, err := http.NewRequest(method, url, nil)
+	req, err := http.NewRequest(method, url, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -121,6 +136,9 @@ func (c *Client) makeRequest(method, endpoint string) ([]byte, error) {
 	// Basic Auth ekle
 	auth := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("riot:%s", c.token)))
 	req.Header.Add("Authorization", "Basic "+auth)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
 
 	resp, err := c.client.Do(req)
 	if err != nil {
@@ -128,13 +146,27 @@ func (c *Client) makeRequest(method, endpoint string) ([]byte, error) {
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
+	// PUT/POST/DELETE çağrıları genelde 204 döner
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, endpoint)
 	}
 
 	return io.ReadAll(resp.Body)
 }
 
+// call üretilmiş (*_gen.go) endpoint fonksiyonlarının ortak yardımcısıdır.
+// out nil değilse yanıt gövdesi out'a decode edilir.
+func (c *Client) call(method, endpoint string, body, out any) error {
+	data, err := c.doRequest(method, endpoint, body)
+	if err != nil {
+		return err
+	}
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
+
 // GetCurrentSummoner aktif summoner bilgisini alır
 func (c *Client) GetCurrentSummoner() (*Summoner, error) {
 	data, err := c.makeRequest("GET", "/lol-summoner/v1/current-summoner")
@@ -150,38 +182,53 @@ func (c *Client) GetCurrentSummoner() (*Summoner, error) {
 	return &summoner, nil
 }
 
-// GetActiveGame aktif oyun bilgisini alır
-func (c *Client) GetActiveGame() (*GameData, error) {
-	data, err := c.makeRequest("GET", "/lol-gameflow/v1/session")
+// GetBuildVersion istemcinin oyun sürümünü alır (ör. "14.3.561.1234")
+func (c *Client) GetBuildVersion() (string, error) {
+	data, err := c.makeRequest("GET", "/system/v1/builds")
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	var session GameFlowSession
-	if err := json.Unmarshal(data, &session); err != nil {
+	var build struct {
+		Version string `json:"version"`
+	}
+	if err := json.Unmarshal(data, &build); err != nil {
+		return "", err
+	}
+
+	return build.Version, nil
+}
+
+// GetActiveGame aktif oyun bilgisini alır
+func (c *Client) GetActiveGame() (*GameData, error) {
+	session, err := c.GetLolGameflowV1Session()
+	if err != nil {
 		return nil, err
 	}
 
 	// Oyun durumunu kontrol et
-	if session.Phase != "InProgress" && session.Phase != "ChampSelect" {
+	if session.Phase != LolGameflowGameflowPhaseInProgress && session.Phase != LolGameflowGameflowPhaseChampSelect {
 		return nil, fmt.Errorf("aktif oyun yok, durum: %s", session.Phase)
 	}
 
 	gameData := &GameData{
-		Phase:    session.Phase,
+		Phase:    string(session.Phase),
 		GameTime: 0,
+		QueueID:  int(session.GameData.Queue.ID),
+		MapID:    int(session.GameData.Queue.MapID),
+		GameMode: session.GameData.Queue.GameMode,
 	}
 
 	// Champion select'teyse
-	if session.Phase == "ChampSelect" {
-		champSelect, err := c.GetChampSelectSession()
+	if session.Phase == LolGameflowGameflowPhaseChampSelect {
+		champSelect, err := c.GetLolChampSelectV1Session()
 		if err == nil {
 			gameData.ChampSelect = champSelect
 		}
 	}
 
 	// Oyun içindeyse
-	if session.Phase == "InProgress" {
+	if session.Phase == LolGameflowGameflowPhaseInProgress {
 		gameInfo, err := c.GetInGameInfo()
 		if err == nil {
 			gameData.InGame = gameInfo
@@ -191,31 +238,10 @@ func (c *Client) GetActiveGame() (*GameData, error) {
 	return gameData, nil
 }
 
-// GetChampSelectSession champion select bilgisini alır
-func (c *Client) GetChampSelectSession() (*ChampSelectSession, error) {
-	data, err := c.makeRequest("GET", "/lol-champ-select/v1/session")
-	if err != nil {
-		return nil, err
-	}
-
-	var session ChampSelectSession
-	if err := json.Unmarshal(data, &session); err != nil {
-		return nil, err
-	}
-
-	return &session, nil
-}
-
 // GetInGameInfo oyun içi bilgileri alır
 func (c *Client) GetInGameInfo() (*InGameInfo, error) {
 	// Aktif oyuncu bilgisi
-	playerData, err := c.makeRequest("GET", "/lol-gameflow/v1/session")
-	if err != nil {
-		return nil, err
-	}
-
-	var session GameFlowSession
-	if err := json.Unmarshal(playerData, &session); err != nil {
+	if _, err := c.GetLolGameflowV1Session(); err != nil {
 		return nil, err
 	}
 
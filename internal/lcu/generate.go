@@ -0,0 +1,6 @@
+package lcu
+
+// *_gen.go dosyaları schema/openapi.json dökümünden üretilir.
+// Yeni bir namespace eklemek için dökümü güncelleyip listeye ekleyin ve
+// "go generate ./internal/lcu" çalıştırın.
+//go:generate go run ../../cmd/lcugen -schema schema/openapi.json -out . -namespaces lol-champ-select,lol-game-data,lol-gameflow,lol-item-sets,lol-lobby,lol-match-history,lol-matchmaking,lol-patch,lol-perks,lol-ranked
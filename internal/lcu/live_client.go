@@ -1,12 +1,14 @@
 package lcu
 
 import (
-"crypto/tls"
-"encoding/json"
-"fmt"
-"io"
-"net/http"
-"time"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 )
 
 // LiveClient Live Client Data API istemcisi
@@ -30,27 +32,152 @@ func NewLiveClient() *LiveClient {
 	}
 }
 
-// GetAllGameData tüm oyun verisini çeker
-func (c *LiveClient) GetAllGameData() (*LiveGameData, error) {
-	resp, err := c.client.Get(c.baseURL + "/allgamedata")
+// get Live Client Data API'sine GET isteği yapar ve yanıtı out'a decode eder
+func (c *LiveClient) get(endpoint string, query url.Values, out any) error {
+	u := c.baseURL + endpoint
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	resp, err := c.client.Get(u)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("Live Client API hatası: %d", resp.StatusCode)
+		return fmt.Errorf("Live Client API hatası: %d", resp.StatusCode)
 	}
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
+	return json.Unmarshal(body, out)
+}
+
+// playerQuery tek oyunculu endpointler için riotId parametresi
+func playerQuery(riotID string) url.Values {
+	return url.Values{"riotId": {riotID}}
+}
+
+// GetAllGameData tüm oyun verisini çeker
+func (c *LiveClient) GetAllGameData() (*LiveGameData, error) {
 	var data LiveGameData
-	if err := json.Unmarshal(body, &data); err != nil {
+	if err := c.get("/allgamedata", nil, &data); err != nil {
 		return nil, err
 	}
-
 	return &data, nil
 }
+
+// GetActivePlayer yerel oyuncunun yetenek, istatistik ve rün bilgisini çeker
+func (c *LiveClient) GetActivePlayer() (*LiveActivePlayer, error) {
+	var player LiveActivePlayer
+	if err := c.get("/activeplayer", nil, &player); err != nil {
+		return nil, err
+	}
+	return &player, nil
+}
+
+// GetActivePlayerName yerel oyuncunun Riot ID'sini çeker
+func (c *LiveClient) GetActivePlayerName() (string, error) {
+	var name string
+	if err := c.get("/activeplayername", nil, &name); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// GetActivePlayerAbilities yerel oyuncunun yeteneklerini ve seviyelerini çeker
+func (c *LiveClient) GetActivePlayerAbilities() (*LiveAbilities, error) {
+	var abilities LiveAbilities
+	if err := c.get("/activeplayerabilities", nil, &abilities); err != nil {
+		return nil, err
+	}
+	return &abilities, nil
+}
+
+// GetActivePlayerRunes yerel oyuncunun tüm rün sayfasını çeker
+func (c *LiveClient) GetActivePlayerRunes() (*LiveFullRunes, error) {
+	var runes LiveFullRunes
+	if err := c.get("/activeplayerrunes", nil, &runes); err != nil {
+		return nil, err
+	}
+	return &runes, nil
+}
+
+// GetPlayerList oyuncu listesini çeker. team boşsa tüm oyuncular, değilse
+// sadece o takım (ORDER, CHAOS) döner.
+func (c *LiveClient) GetPlayerList(team string) ([]LivePlayer, error) {
+	var query url.Values
+	if team != "" {
+		query = url.Values{"teamID": {team}}
+	}
+
+	var players []LivePlayer
+	if err := c.get("/playerlist", query, &players); err != nil {
+		return nil, err
+	}
+	return players, nil
+}
+
+// GetPlayerScores oyuncunun skorlarını çeker
+func (c *LiveClient) GetPlayerScores(riotID string) (*LiveScores, error) {
+	var scores LiveScores
+	if err := c.get("/playerscores", playerQuery(riotID), &scores); err != nil {
+		return nil, err
+	}
+	return &scores, nil
+}
+
+// GetPlayerSummonerSpells oyuncunun sihirdar büyülerini çeker
+func (c *LiveClient) GetPlayerSummonerSpells(riotID string) (*LiveSpells, error) {
+	var spells LiveSpells
+	if err := c.get("/playersummonerspells", playerQuery(riotID), &spells); err != nil {
+		return nil, err
+	}
+	return &spells, nil
+}
+
+// GetPlayerMainRunes oyuncunun keystone ve rün ağaçlarını çeker
+func (c *LiveClient) GetPlayerMainRunes(riotID string) (*LiveRunes, error) {
+	var runes LiveRunes
+	if err := c.get("/playermainrunes", playerQuery(riotID), &runes); err != nil {
+		return nil, err
+	}
+	return &runes, nil
+}
+
+// GetPlayerItems oyuncunun itemlerini çeker
+func (c *LiveClient) GetPlayerItems(riotID string) ([]LiveItem, error) {
+	var items []LiveItem
+	if err := c.get("/playeritems", playerQuery(riotID), &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// GetEventData oyun olaylarını çeker. fromEventID > 0 ise sadece bu ID ve
+// sonrasındaki olaylar döner, aksi halde tüm olaylar döner.
+func (c *LiveClient) GetEventData(fromEventID int) ([]LiveEvent, error) {
+	var query url.Values
+	if fromEventID > 0 {
+		query = url.Values{"eventID": {strconv.Itoa(fromEventID)}}
+	}
+
+	var list LiveEventList
+	if err := c.get("/eventdata", query, &list); err != nil {
+		return nil, err
+	}
+	return list.Events, nil
+}
+
+// GetGameStats oyun süresi, mod ve harita bilgisini çeker
+func (c *LiveClient) GetGameStats() (*LiveGameStats, error) {
+	var stats LiveGameStats
+	if err := c.get("/gamestats", nil, &stats); err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
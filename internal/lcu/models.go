@@ -12,53 +12,15 @@ type Summoner struct {
 	SummonerLevel int    `json:"summonerLevel"`
 }
 
-// GameFlowSession oyun akış durumu
-type GameFlowSession struct {
-	Phase string `json:"phase"` // None, Lobby, Matchmaking, ChampSelect, InProgress, etc.
-}
-
 // GameData oyun verisi
 type GameData struct {
-	Phase       string              `json:"phase"`
-	GameTime    int                 `json:"gameTime"`
-	ChampSelect *ChampSelectSession `json:"champSelect,omitempty"`
-	InGame      *InGameInfo         `json:"inGame,omitempty"`
-}
-
-// ChampSelectSession champion seçim bilgisi
-type ChampSelectSession struct {
-	Actions       [][]ChampSelectAction `json:"actions"`
-	AlliedTeam    []ChampSelectPlayer   `json:"myTeam"`
-	EnemyTeam     []ChampSelectPlayer   `json:"theirTeam"`
-	LocalPlayerID int64                 `json:"localPlayerCellId"`
-	Timer         ChampSelectTimer      `json:"timer"`
-}
-
-// ChampSelectAction champion seçim aksiyonu
-type ChampSelectAction struct {
-	ActorCellID int64  `json:"actorCellId"`
-	ChampionID  int    `json:"championId"`
-	Completed   bool   `json:"completed"`
-	ID          int64  `json:"id"`
-	Type        string `json:"type"` // pick, ban
-}
-
-// ChampSelectPlayer champion select'teki oyuncu
-type ChampSelectPlayer struct {
-	CellID       int64  `json:"cellId"`
-	ChampionID   int    `json:"championId"`
-	ChampionName string `json:"championPickIntent"`
-	SummonerID   int64  `json:"summonerId"`
-	Team         int    `json:"team"`
-}
-
-// ChampSelectTimer sayaç bilgisi
-type ChampSelectTimer struct {
-	AdjustedTimeLeftInPhase int64  `json:"adjustedTimeLeftInPhase"`
-	InternalNowInEpochMs    int64  `json:"internalNowInEpochMs"`
-	IsInfinite              bool   `json:"isInfinite"`
-	Phase                   string `json:"phase"`
-	TotalTimeInPhase        int64  `json:"totalTimeInPhase"`
+	Phase       string                            `json:"phase"`
+	GameTime    int                               `json:"gameTime"`
+	QueueID     int                               `json:"queueId"`
+	MapID       int                               `json:"mapId"`
+	GameMode    string                            `json:"gameMode"`
+	ChampSelect *LolChampSelectChampSelectSession `json:"champSelect,omitempty"`
+	InGame      *InGameInfo                       `json:"inGame,omitempty"`
 }
 
 // InGameInfo oyun içi bilgi
@@ -94,67 +56,160 @@ type Item struct {
 type LiveGameData struct {
 	ActivePlayer LiveActivePlayer `json:"activePlayer"`
 	AllPlayers   []LivePlayer     `json:"allPlayers"`
+	Events       LiveEventList    `json:"events"`
 	GameData     LiveGameStats    `json:"gameData"`
 }
 
+// LiveActivePlayer oyunu oynayan (yerel) oyuncu
 type LiveActivePlayer struct {
-	SummonerName string `json:"summonerName"`
-	Level        int    `json:"level"`
-	CurrentGold  float64 `json:"currentGold"`
+	Abilities      LiveAbilities     `json:"abilities"`
+	ChampionStats  LiveChampionStats `json:"championStats"`
+	CurrentGold    float64           `json:"currentGold"`
+	FullRunes      LiveFullRunes     `json:"fullRunes"`
+	Level          int               `json:"level"`
+	RiotID         string            `json:"riotId"`
+	RiotIDGameName string            `json:"riotIdGameName"`
+	RiotIDTagLine  string            `json:"riotIdTagLine"`
+	SummonerName   string            `json:"summonerName"`
+
+	// Error izleyici modunda alanların yerine gelen açıklama
+	// ("Spectator mode doesn't currently support this feature")
+	Error string `json:"error"`
+}
+
+// IsSpectator oyunun izleyici modunda (veya tekrar olarak) açıldığını bildirir
+func (p *LiveActivePlayer) IsSpectator() bool {
+	return p.Error != "" || (p.SummonerName == "" && p.RiotID == "")
+}
+
+// LiveAbilities aktif oyuncunun yetenekleri
+type LiveAbilities struct {
+	Passive LiveAbility `json:"Passive"`
+	Q       LiveAbility `json:"Q"`
+	W       LiveAbility `json:"W"`
+	E       LiveAbility `json:"E"`
+	R       LiveAbility `json:"R"`
+}
+
+// LiveAbility tek bir yetenek (pasifte AbilityLevel yoktur)
+type LiveAbility struct {
+	AbilityLevel   int    `json:"abilityLevel"`
+	DisplayName    string `json:"displayName"`
+	ID             string `json:"id"`
+	RawDescription string `json:"rawDescription"`
+	RawDisplayName string `json:"rawDisplayName"`
+}
+
+// LiveChampionStats aktif oyuncunun anlık şampiyon istatistikleri
+type LiveChampionStats struct {
+	AbilityHaste                 float64 `json:"abilityHaste"`
+	AbilityPower                 float64 `json:"abilityPower"`
+	Armor                        float64 `json:"armor"`
+	ArmorPenetrationFlat         float64 `json:"armorPenetrationFlat"`
+	ArmorPenetrationPercent      float64 `json:"armorPenetrationPercent"`
+	AttackDamage                 float64 `json:"attackDamage"`
+	AttackRange                  float64 `json:"attackRange"`
+	AttackSpeed                  float64 `json:"attackSpeed"`
+	BonusArmorPenetrationPercent float64 `json:"bonusArmorPenetrationPercent"`
+	BonusMagicPenetrationPercent float64 `json:"bonusMagicPenetrationPercent"`
+	CritChance                   float64 `json:"critChance"`
+	CritDamage                   float64 `json:"critDamage"`
+	CurrentHealth                float64 `json:"currentHealth"`
+	HealShieldPower              float64 `json:"healShieldPower"`
+	HealthRegenRate              float64 `json:"healthRegenRate"`
+	LifeSteal                    float64 `json:"lifeSteal"`
+	MagicLethality               float64 `json:"magicLethality"`
+	MagicPenetrationFlat         float64 `json:"magicPenetrationFlat"`
+	MagicPenetrationPercent      float64 `json:"magicPenetrationPercent"`
+	MagicResist                  float64 `json:"magicResist"`
+	MaxHealth                    float64 `json:"maxHealth"`
+	MoveSpeed                    float64 `json:"moveSpeed"`
+	Omnivamp                     float64 `json:"omnivamp"`
+	PhysicalLethality            float64 `json:"physicalLethality"`
+	PhysicalVamp                 float64 `json:"physicalVamp"`
+	ResourceMax                  float64 `json:"resourceMax"`
+	ResourceRegenRate            float64 `json:"resourceRegenRate"`
+	ResourceType                 string  `json:"resourceType"`
+	ResourceValue                float64 `json:"resourceValue"`
+	SpellVamp                    float64 `json:"spellVamp"`
+	Tenacity                     float64 `json:"tenacity"`
 }
 
+// LiveFullRunes aktif oyuncunun tüm rün sayfası (stat shard'lar dahil)
+type LiveFullRunes struct {
+	GeneralRunes      []LiveRune     `json:"generalRunes"`
+	Keystone          LiveRune       `json:"keystone"`
+	PrimaryRuneTree   LiveRuneTree   `json:"primaryRuneTree"`
+	SecondaryRuneTree LiveRuneTree   `json:"secondaryRuneTree"`
+	StatRunes         []LiveStatRune `json:"statRunes"`
+}
+
+// LiveStatRune stat shard (adaptif güç, saldırı hızı, can vb.)
+type LiveStatRune struct {
+	ID             int    `json:"id"`
+	RawDescription string `json:"rawDescription"`
+}
+
+// LivePlayer oyundaki herhangi bir oyuncu
 type LivePlayer struct {
-	ChampionName    string       `json:"championName"`
-	IsBot           bool         `json:"isBot"`
-	IsDead          bool         `json:"isDead"`
-	Items           []LiveItem   `json:"items"`
-	Level           int          `json:"level"`
-	Position        string       `json:"position"`
-	RawChampionName string       `json:"rawChampionName"`
-	RespawnTimer    float64      `json:"respawnTimer"`
-	Runes           LiveRunes    `json:"runes"`
-	Scores          LiveScores   `json:"scores"`
-	SkinID          int          `json:"skinID"`
-	SummonerName    string       `json:"summonerName"`
-	SummonerSpells  LiveSpells   `json:"summonerSpells"`
-	Team            string       `json:"team"` // ORDER, CHAOS
+	ChampionName    string     `json:"championName"`
+	IsBot           bool       `json:"isBot"`
+	IsDead          bool       `json:"isDead"`
+	Items           []LiveItem `json:"items"`
+	Level           int        `json:"level"`
+	Position        string     `json:"position"`
+	RawChampionName string     `json:"rawChampionName"`
+	RespawnTimer    float64    `json:"respawnTimer"`
+	RiotID          string     `json:"riotId"`
+	RiotIDGameName  string     `json:"riotIdGameName"`
+	RiotIDTagLine   string     `json:"riotIdTagLine"`
+	Runes           LiveRunes  `json:"runes"`
+	Scores          LiveScores `json:"scores"`
+	SkinID          int        `json:"skinID"`
+	SummonerName    string     `json:"summonerName"`
+	SummonerSpells  LiveSpells `json:"summonerSpells"`
+	Team            string     `json:"team"` // ORDER, CHAOS
 }
 
+// LiveItem oyuncunun envanterindeki item
 type LiveItem struct {
-	CanUse      bool   `json:"canUse"`
-	Consumable  bool   `json:"consumable"`
-	Count       int    `json:"count"`
-	DisplayName string `json:"displayName"`
-	ItemID      int    `json:"itemID"`
-	Price       int    `json:"price"`
+	CanUse         bool   `json:"canUse"`
+	Consumable     bool   `json:"consumable"`
+	Count          int    `json:"count"`
+	DisplayName    string `json:"displayName"`
+	ItemID         int    `json:"itemID"`
+	Price          int    `json:"price"`
 	RawDescription string `json:"rawDescription"`
 	RawDisplayName string `json:"rawDisplayName"`
-	Slot        int    `json:"slot"`
+	Slot           int    `json:"slot"`
 }
 
+// LiveRunes oyuncunun ana rünleri (diğer oyuncular için sadece bunlar görünür)
 type LiveRunes struct {
-	Keystone LiveRune `json:"keystone"`
-	PrimaryRuneTree LiveRuneTree `json:"primaryRuneTree"`
+	Keystone          LiveRune     `json:"keystone"`
+	PrimaryRuneTree   LiveRuneTree `json:"primaryRuneTree"`
 	SecondaryRuneTree LiveRuneTree `json:"secondaryRuneTree"`
 }
 
 type LiveRune struct {
-	DisplayName string `json:"displayName"`
-	ID          int    `json:"id"`
+	DisplayName    string `json:"displayName"`
+	ID             int    `json:"id"`
 	RawDescription string `json:"rawDescription"`
+	RawDisplayName string `json:"rawDisplayName"`
 }
 
 type LiveRuneTree struct {
-	DisplayName string `json:"displayName"`
-	ID          int    `json:"id"`
+	DisplayName    string `json:"displayName"`
+	ID             int    `json:"id"`
 	RawDescription string `json:"rawDescription"`
+	RawDisplayName string `json:"rawDisplayName"`
 }
 
 type LiveScores struct {
-	Assists    int `json:"assists"`
-	CreepScore int `json:"creepScore"`
-	Deaths     int `json:"deaths"`
-	Kills      int `json:"kills"`
+	Assists    int     `json:"assists"`
+	CreepScore int     `json:"creepScore"`
+	Deaths     int     `json:"deaths"`
+	Kills      int     `json:"kills"`
 	WardScore  float64 `json:"wardScore"`
 }
 
@@ -164,14 +219,42 @@ type LiveSpells struct {
 }
 
 type LiveSpell struct {
-	DisplayName string `json:"displayName"`
+	DisplayName    string `json:"displayName"`
 	RawDescription string `json:"rawDescription"`
 	RawDisplayName string `json:"rawDisplayName"`
 }
 
+// LiveEventList /eventdata yanıtı
+type LiveEventList struct {
+	Events []LiveEvent `json:"Events"`
+}
+
+// LiveEvent oyun olayı. Olay tipine göre sadece ilgili alanlar doludur.
+type LiveEvent struct {
+	EventID             int      `json:"EventID"`
+	EventName           string   `json:"EventName"` // GameStart, ChampionKill, DragonKill, Multikill, Ace, ...
+	EventTime           float64  `json:"EventTime"`
+	Acer                string   `json:"Acer,omitempty"`
+	AcingTeam           string   `json:"AcingTeam,omitempty"`
+	Assisters           []string `json:"Assisters,omitempty"`
+	DragonType          string   `json:"DragonType,omitempty"`
+	InhibKilled         string   `json:"InhibKilled,omitempty"`
+	InhibRespawned      string   `json:"InhibRespawned,omitempty"`
+	InhibRespawningSoon string   `json:"InhibRespawningSoon,omitempty"`
+	KillStreak          int      `json:"KillStreak,omitempty"`
+	KillerName          string   `json:"KillerName,omitempty"`
+	Recipient           string   `json:"Recipient,omitempty"`
+	Result              string   `json:"Result,omitempty"` // GameEnd: Win, Lose
+	Stolen              string   `json:"Stolen,omitempty"` // "True", "False"
+	TurretKilled        string   `json:"TurretKilled,omitempty"`
+	VictimName          string   `json:"VictimName,omitempty"`
+}
+
+// LiveGameStats /gamestats yanıtı
 type LiveGameStats struct {
-	GameTime float64 `json:"gameTime"`
-	MapName  string  `json:"mapName"`
-	MapNumber int    `json:"mapNumber"`
-	MapTerrain string `json:"mapTerrain"`
+	GameMode   string  `json:"gameMode"` // CLASSIC, ARAM, CHERRY, ...
+	GameTime   float64 `json:"gameTime"`
+	MapName    string  `json:"mapName"`
+	MapNumber  int     `json:"mapNumber"`
+	MapTerrain string  `json:"mapTerrain"`
 }
@@ -0,0 +1,190 @@
+package lol
+
+import (
+	"strings"
+	"sync"
+
+	"lol-helper/internal/lcu"
+)
+
+// arenaDuoSize Arena'da bir takımdaki oyuncu sayısı
+const arenaDuoSize = 2
+
+// ArenaDuo Arena'daki iki kişilik takım
+type ArenaDuo struct {
+	ID         int      // 1'den başlar
+	Players    []string // Sihirdar adları
+	Champions  []string
+	Eliminated bool // İkilinin iki oyuncusu da tur sonunda canlanmadı
+}
+
+// Label ikiliyi "Ahri + Leona" biçiminde döner
+func (d ArenaDuo) Label() string {
+	return strings.Join(d.Champions, " + ")
+}
+
+// ArenaState Arena'ya özel oyun durumu
+type ArenaState struct {
+	Round    int
+	Duos     []ArenaDuo
+	LocalDuo int      // Yerel oyuncunun ikilisi; izleyicide 0
+	Augments []string // Yerel oyuncunun seçtiği augmentler (seçim sırasıyla)
+}
+
+// Duo oyuncunun ikilisini döner
+func (s *ArenaState) Duo(summonerName string) (ArenaDuo, bool) {
+	for _, d := range s.Duos {
+		for _, p := range d.Players {
+			if p == summonerName {
+				return d, true
+			}
+		}
+	}
+	return ArenaDuo{}, false
+}
+
+// Opponents yerel ikili dışındaki ikilileri döner
+func (s *ArenaState) Opponents() []ArenaDuo {
+	var out []ArenaDuo
+	for _, d := range s.Duos {
+		if d.ID != s.LocalDuo {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+// Partner yerel ikilideki diğer şampiyonu döner
+func (s *ArenaState) Partner(champion string) string {
+	for _, d := range s.Duos {
+		if d.ID != s.LocalDuo {
+			continue
+		}
+		for _, c := range d.Champions {
+			if c != champion {
+				return c
+			}
+		}
+	}
+	return ""
+}
+
+// OpponentLabels elenmemiş rakip ikilileri "Ahri + Leona" biçiminde döner
+func (s *ArenaState) OpponentLabels() []string {
+	var out []string
+	for _, d := range s.Opponents() {
+		if !d.Eliminated {
+			out = append(out, d.Label())
+		}
+	}
+	return out
+}
+
+// ArenaTracker Arena ikililerini, turları ve seçilen augmentleri takip eder.
+//
+// Live Client Data API tur numarası vermez; her tur sonunda ölen oyuncular topluca
+// canlandığı için ölü sayısının bir polling'de en az iki azalması yeni tur sayılır.
+// O anda hâlâ ölü olan oyuncular elenmiştir.
+type ArenaTracker struct {
+	mu           sync.Mutex
+	round        int
+	dead         map[string]bool
+	eliminated   map[string]bool
+	augments     []string
+	lastGameTime float64
+}
+
+// NewArenaTracker yeni bir Arena takipçisi oluşturur
+func NewArenaTracker() *ArenaTracker {
+	t := &ArenaTracker{}
+	t.reset()
+	return t
+}
+
+func (t *ArenaTracker) reset() {
+	t.round = 1
+	t.dead = make(map[string]bool)
+	t.eliminated = make(map[string]bool)
+	t.augments = nil
+	t.lastGameTime = 0
+}
+
+// AddAugment yerel oyuncunun seçtiği augmenti kaydeder
+func (t *ArenaTracker) AddAugment(name string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if name = strings.TrimSpace(name); name != "" {
+		t.augments = append(t.augments, name)
+	}
+}
+
+// Update oyuncu listesinden ikilileri ve turu günceller. localName yerel oyuncu
+// (izleyicide bakış açısı oyuncusu); boşsa yerel ikili belirlenmez.
+func (t *ArenaTracker) Update(players []lcu.LivePlayer, localName string, gameTime float64) ArenaState {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if gameTime < t.lastGameTime {
+		t.reset()
+	}
+	t.lastGameTime = gameTime
+
+	dead := make(map[string]bool)
+	for _, p := range players {
+		if p.IsDead {
+			dead[p.SummonerName] = true
+		}
+	}
+	if len(t.dead)-len(dead) >= arenaDuoSize {
+		t.round++
+		for name := range dead {
+			t.eliminated[name] = true
+		}
+	}
+	t.dead = dead
+
+	state := ArenaState{Round: t.round, Augments: append([]string(nil), t.augments...)}
+	for i, group := range groupArenaDuos(players) {
+		duo := ArenaDuo{ID: i + 1, Eliminated: true}
+		for _, p := range group {
+			duo.Players = append(duo.Players, p.SummonerName)
+			duo.Champions = append(duo.Champions, p.ChampionName)
+			if !t.eliminated[p.SummonerName] {
+				duo.Eliminated = false
+			}
+			if localName != "" && p.SummonerName == localName {
+				state.LocalDuo = duo.ID
+			}
+		}
+		state.Duos = append(state.Duos, duo)
+	}
+	return state
+}
+
+// groupArenaDuos oyuncuları ikililere ayırır. Team alanı ikiden fazla değer
+// taşıyorsa ona göre gruplanır; aksi halde istemci ikilileri art arda
+// listelediği için sıraya göre ikişerli gruplanır.
+func groupArenaDuos(players []lcu.LivePlayer) [][]lcu.LivePlayer {
+	var teams []string
+	byTeam := make(map[string][]lcu.LivePlayer)
+	for _, p := range players {
+		if _, ok := byTeam[p.Team]; !ok {
+			teams = append(teams, p.Team)
+		}
+		byTeam[p.Team] = append(byTeam[p.Team], p)
+	}
+	if len(teams) > 2 {
+		groups := make([][]lcu.LivePlayer, 0, len(teams))
+		for _, team := range teams {
+			groups = append(groups, byTeam[team])
+		}
+		return groups
+	}
+
+	var groups [][]lcu.LivePlayer
+	for i := 0; i < len(players); i += arenaDuoSize {
+		end := min(i+arenaDuoSize, len(players))
+		groups = append(groups, players[i:end])
+	}
+	return groups
+}
@@ -0,0 +1,252 @@
+package lol
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"sync"
+
+	"lol-helper/internal/appdir"
+	"lol-helper/internal/lcu"
+)
+
+// defaultBenchmarks uygulamayla gelen hedef değerler; veri klasörüne düzenlenebilir kopyası yazılır
+//
+//go:embed benchmarks.json
+var defaultBenchmarks []byte
+
+// benchmarkMinGameTime dakika başı değerlerin anlamlı olmaya başladığı oyun saniyesi
+const benchmarkMinGameTime = 180
+
+// BenchmarkMinutes hedef farkının kaydedildiği dakikalar
+var BenchmarkMinutes = []int{10, 15, 20}
+
+// tierAliases hedef dosyasında ayrıca tutulmayan kademeler
+var tierAliases = map[string]string{
+	"GRANDMASTER": "MASTER",
+	"CHALLENGER":  "MASTER",
+}
+
+// BenchmarkTarget bir rol ve kademe için dakika başı hedefler
+type BenchmarkTarget struct {
+	CSPerMin          float64 `json:"csPerMin"`
+	VisionPerMin      float64 `json:"visionPerMin"`
+	KillParticipation float64 `json:"killParticipation"` // 0-1 arası
+}
+
+// Benchmarks rol ve kademe bazında hedef tablosu
+type Benchmarks struct {
+	DefaultTier string `json:"defaultTier"`
+	Grades      struct {
+		Good  float64 `json:"good"`  // Hedefe oran bunun üzerindeyse yeşil
+		Close float64 `json:"close"` // Bunun üzerindeyse sarı, altındaysa kırmızı
+	} `json:"grades"`
+	Tiers map[string]map[string]BenchmarkTarget `json:"tiers"`
+}
+
+// LoadBenchmarks hedef tablosunu path'ten okur. Dosya yoksa gömülü varsayılanlar
+// oraya yazılır ki kullanıcı düzenleyebilsin. path boşsa gömülü tablo kullanılır.
+func LoadBenchmarks(path string) (*Benchmarks, error) {
+	data := defaultBenchmarks
+	if path != "" {
+		fileData, err := os.ReadFile(path)
+		switch {
+		case err == nil:
+			data = fileData
+		case errors.Is(err, fs.ErrNotExist):
+			if err := appdir.WriteFile(path, defaultBenchmarks); err != nil {
+				return nil, fmt.Errorf("hedef dosyası yazılamadı: %w", err)
+			}
+		default:
+			return nil, fmt.Errorf("hedef dosyası okunamadı: %w", err)
+		}
+	}
+
+	var b Benchmarks
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, fmt.Errorf("hedef dosyası geçersiz: %w", err)
+	}
+	return &b, nil
+}
+
+// DefaultBenchmarks gömülü hedef tablosunu döner
+func DefaultBenchmarks() *Benchmarks {
+	var b Benchmarks
+	_ = json.Unmarshal(defaultBenchmarks, &b)
+	return &b
+}
+
+// Target kademe ve rol için hedefi döner. Kademe tabloda yoksa varsayılan kademeye düşer.
+func (b *Benchmarks) Target(tier, role string) (BenchmarkTarget, bool) {
+	tier = strings.ToUpper(tier)
+	if alias, ok := tierAliases[tier]; ok {
+		tier = alias
+	}
+	roles, ok := b.Tiers[tier]
+	if !ok {
+		roles = b.Tiers[b.DefaultTier]
+	}
+	t, ok := roles[role]
+	return t, ok
+}
+
+// BenchmarkGrade hedefe yakınlık derecesi
+type BenchmarkGrade int
+
+const (
+	GradeNone   BenchmarkGrade = iota // Rol bilinmiyor veya oyun çok erken
+	GradeGood                         // Hedefte veya üstünde
+	GradeClose                        // Hedefe yakın
+	GradeBehind                       // Hedefin belirgin altında
+)
+
+// BenchmarkCheckpoint belirli bir dakikada hedefe göre fark (gerçek - hedef)
+type BenchmarkCheckpoint struct {
+	Minute    int
+	CSGap     float64
+	VisionGap float64
+	KPGap     float64
+}
+
+// BenchmarkResult bir oyuncunun anlık değerleri ve hedefe göre durumu
+type BenchmarkResult struct {
+	Position          string
+	CSPerMin          float64
+	VisionPerMin      float64
+	KillParticipation float64
+	Target            BenchmarkTarget
+	Grade             BenchmarkGrade
+	Checkpoints       []BenchmarkCheckpoint
+}
+
+// BenchmarkTracker oyuncuların CS/dk, görüş/dk ve skor katılımını hedeflerle karşılaştırır
+type BenchmarkTracker struct {
+	mu           sync.Mutex
+	benchmarks   *Benchmarks
+	tier         string
+	checkpoints  map[string][]BenchmarkCheckpoint
+	lastGameTime float64
+}
+
+// NewBenchmarkTracker verilen hedef tablosuyla bir takipçi oluşturur
+func NewBenchmarkTracker(b *Benchmarks) *BenchmarkTracker {
+	return &BenchmarkTracker{
+		benchmarks:  b,
+		tier:        b.DefaultTier,
+		checkpoints: make(map[string][]BenchmarkCheckpoint),
+	}
+}
+
+// SetTier karşılaştırmada kullanılacak kademeyi ayarlar (örn. GOLD)
+func (t *BenchmarkTracker) SetTier(tier string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if tier != "" {
+		t.tier = tier
+	}
+}
+
+// Tier kullanılan kademeyi döner
+func (t *BenchmarkTracker) Tier() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.tier
+}
+
+// Update oyuncuların güncel değerlerini hesaplar ve kontrol noktası dakikalarında farkı kaydeder
+func (t *BenchmarkTracker) Update(players []lcu.LivePlayer, gameTime float64) map[string]BenchmarkResult {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if gameTime < t.lastGameTime {
+		t.checkpoints = make(map[string][]BenchmarkCheckpoint)
+	}
+	t.lastGameTime = gameTime
+
+	teamKills := make(map[string]int, 2)
+	for _, p := range players {
+		teamKills[p.Team] += p.Scores.Kills
+	}
+
+	minutes := gameTime / 60
+	results := make(map[string]BenchmarkResult, len(players))
+	for _, p := range players {
+		target, ok := t.benchmarks.Target(t.tier, p.Position)
+		if !ok || minutes <= 0 {
+			continue
+		}
+
+		r := BenchmarkResult{
+			Position:     p.Position,
+			CSPerMin:     float64(p.Scores.CreepScore) / minutes,
+			VisionPerMin: p.Scores.WardScore / minutes,
+			Target:       target,
+		}
+		var ratios []float64
+		if target.CSPerMin > 0 {
+			ratios = append(ratios, r.CSPerMin/target.CSPerMin)
+		}
+		if target.VisionPerMin > 0 {
+			ratios = append(ratios, r.VisionPerMin/target.VisionPerMin)
+		}
+		if kills := teamKills[p.Team]; kills > 0 {
+			r.KillParticipation = float64(p.Scores.Kills+p.Scores.Assists) / float64(kills)
+			if target.KillParticipation > 0 {
+				ratios = append(ratios, r.KillParticipation/target.KillParticipation)
+			}
+		}
+
+		if gameTime >= benchmarkMinGameTime && len(ratios) > 0 {
+			r.Grade = t.grade(ratios)
+		}
+
+		// Kontrol noktası dakikadan sonraki ilk polling'de kaydedilir; duraklama, bağlantı
+		// kopması veya uygulamanın geç açılması noktayı atlatmaz
+		for _, minute := range BenchmarkMinutes {
+			if gameTime < float64(minute*60) || t.hasCheckpoint(p.SummonerName, minute) {
+				continue
+			}
+			t.checkpoints[p.SummonerName] = append(t.checkpoints[p.SummonerName], BenchmarkCheckpoint{
+				Minute:    minute,
+				CSGap:     r.CSPerMin - target.CSPerMin,
+				VisionGap: r.VisionPerMin - target.VisionPerMin,
+				KPGap:     r.KillParticipation - target.KillParticipation,
+			})
+		}
+		r.Checkpoints = append([]BenchmarkCheckpoint(nil), t.checkpoints[p.SummonerName]...)
+
+		results[p.SummonerName] = r
+	}
+	return results
+}
+
+func (t *BenchmarkTracker) hasCheckpoint(player string, minute int) bool {
+	for _, c := range t.checkpoints[player] {
+		if c.Minute == minute {
+			return true
+		}
+	}
+	return false
+}
+
+// grade hedefe oranların ortalamasından derece çıkarır
+func (t *BenchmarkTracker) grade(ratios []float64) BenchmarkGrade {
+	sum := 0.0
+	for _, r := range ratios {
+		sum += r
+	}
+	avg := sum / float64(len(ratios))
+
+	switch {
+	case avg >= t.benchmarks.Grades.Good:
+		return GradeGood
+	case avg >= t.benchmarks.Grades.Close:
+		return GradeClose
+	default:
+		return GradeBehind
+	}
+}
@@ -0,0 +1,317 @@
+package lol
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"lol-helper/internal/i18n"
+	"lol-helper/internal/lcu"
+	"lol-helper/internal/staticdata"
+)
+
+const (
+	// compositionSkewedDamage hasarın tek tipe bu oranın üzerinde yığılması rakibe kolay savunma verir
+	compositionSkewedDamage = 0.75
+	// compositionArchetypeMin bir arketipin baskın sayılması için gereken en az şampiyon sayısı
+	compositionArchetypeMin = 2
+	// compositionHeavyCC takımın "yoğun kitle kontrolü" sayıldığı toplam sert CC sayısı
+	compositionHeavyCC = 7
+)
+
+// Archetype takımın oyun planını belirleyen baskın tarz
+type Archetype string
+
+const (
+	ArchetypePoke      Archetype = "poke"
+	ArchetypeDive      Archetype = "dive"
+	ArchetypePick      Archetype = "pick"
+	ArchetypeTeamfight Archetype = "teamfight"
+	ArchetypeBalanced  Archetype = "balanced"
+)
+
+// archetypeKeys arayüzde gösterilen arketip isimlerinin mesaj anahtarları
+var archetypeKeys = map[Archetype]string{
+	ArchetypePoke:      "archetype.poke",
+	ArchetypeDive:      "archetype.dive",
+	ArchetypePick:      "archetype.pick",
+	ArchetypeTeamfight: "archetype.teamfight",
+	ArchetypeBalanced:  "archetype.balanced",
+}
+
+// Label arketipin seçili dildeki adını döner
+func (a Archetype) Label() string {
+	if key, ok := archetypeKeys[a]; ok {
+		return i18n.T(key)
+	}
+	return string(a)
+}
+
+// DamageSplit takımın hasar dağılımı (0-1 arası oranlar, toplamı 1)
+type DamageSplit struct {
+	Physical float64
+	Magic    float64
+	True     float64
+}
+
+// TeamComposition bir takımın kompozisyon analizi
+type TeamComposition struct {
+	Team      string   // ORDER, CHAOS
+	Champions []string // Katalogda bulunan şampiyonlar
+	Unknown   []string // Katalogda olmayan şampiyonlar (analize katılmaz)
+
+	Damage    DamageSplit
+	Frontline int // Ön hat sayısı
+	HardCC    int // Toplam sert kitle kontrolü
+
+	Engage    []string // Savaşı başlatabilen şampiyonlar
+	Disengage []string // Savaştan kaçırabilen / dalışı kesebilen şampiyonlar
+
+	Archetype     Archetype
+	WinConditions []string // Takımın kazanma yolları
+	Warnings      []string // Zayıf yönler
+}
+
+// CompositionAnalysis iki takımın kompozisyon analizi
+type CompositionAnalysis struct {
+	LocalTeam string // Yerel oyuncunun takımı; tarafsız izleyici görünümünde boş
+	Teams     []TeamComposition
+}
+
+// Team takımın analizini döner
+func (c *CompositionAnalysis) Team(team string) (TeamComposition, bool) {
+	for _, t := range c.Teams {
+		if t.Team == team {
+			return t, true
+		}
+	}
+	return TeamComposition{}, false
+}
+
+// AnalyzeComposition bir takımın şampiyonlarından hasar, ön hat, CC ve arketip analizi yapar
+func AnalyzeComposition(data *staticdata.GameData, team string, champions []string) TeamComposition {
+	comp := TeamComposition{Team: team}
+
+	var known []*staticdata.Champion
+	for _, name := range champions {
+		ch, ok := data.ChampionByName(name)
+		if !ok {
+			comp.Unknown = append(comp.Unknown, name)
+			continue
+		}
+		known = append(known, ch)
+		comp.Champions = append(comp.Champions, ch.Name)
+	}
+	if len(known) == 0 {
+		comp.Archetype = ArchetypeBalanced
+		return comp
+	}
+
+	traits := make(map[staticdata.Trait][]string)
+	for _, ch := range known {
+		comp.Damage.Physical += float64(ch.Damage.Physical)
+		comp.Damage.Magic += float64(ch.Damage.Magic)
+		comp.Damage.True += float64(ch.Damage.True)
+		comp.HardCC += ch.HardCC
+		if ch.Frontline {
+			comp.Frontline++
+		}
+		for _, t := range ch.Traits {
+			traits[t] = append(traits[t], ch.Name)
+		}
+	}
+	if total := comp.Damage.Physical + comp.Damage.Magic + comp.Damage.True; total > 0 {
+		comp.Damage.Physical /= total
+		comp.Damage.Magic /= total
+		comp.Damage.True /= total
+	}
+	comp.Engage = traits[staticdata.TraitEngage]
+	comp.Disengage = traits[staticdata.TraitDisengage]
+	comp.Archetype = archetypeOf(traits, comp.HardCC)
+
+	comp.WinConditions = winConditions(comp, traits)
+	comp.Warnings = compositionWarnings(comp, len(known))
+	return comp
+}
+
+// archetypeOf en çok şampiyonun desteklediği arketipi seçer. Eşitlikte
+// takım savaşı, dalış, yakalama, uzaktan yıpratma sırası tercih edilir.
+func archetypeOf(traits map[staticdata.Trait][]string, hardCC int) Archetype {
+	scores := []struct {
+		archetype Archetype
+		score     int
+	}{
+		{ArchetypeTeamfight, len(traits[staticdata.TraitEngage])},
+		{ArchetypeDive, len(traits[staticdata.TraitDive])},
+		{ArchetypePick, len(traits[staticdata.TraitPick])},
+		{ArchetypePoke, len(traits[staticdata.TraitPoke])},
+	}
+	// Engage'i olmayan ama çok CC'li takımlar da savaşta güçlüdür
+	if hardCC >= compositionHeavyCC {
+		scores[0].score++
+	}
+
+	best := ArchetypeBalanced
+	bestScore := compositionArchetypeMin - 1
+	for _, s := range scores {
+		if s.score > bestScore {
+			best, bestScore = s.archetype, s.score
+		}
+	}
+	return best
+}
+
+// winConditions takımın arketipine ve özelliklerine göre kazanma yollarını üretir
+func winConditions(comp TeamComposition, traits map[staticdata.Trait][]string) []string {
+	var conds []string
+	switch comp.Archetype {
+	case ArchetypePoke:
+		conds = append(conds, i18n.T("composition.win.poke"))
+	case ArchetypeDive:
+		conds = append(conds, i18n.T("composition.win.dive"))
+	case ArchetypePick:
+		conds = append(conds, i18n.T("composition.win.pick"))
+	case ArchetypeTeamfight:
+		conds = append(conds, i18n.T("composition.win.teamfight"))
+	}
+	if split := traits[staticdata.TraitSplitpush]; len(split) > 0 {
+		conds = append(conds, i18n.T("composition.win.splitpush", strings.Join(split, ", ")))
+	}
+	if scaling := traits[staticdata.TraitScaling]; len(scaling) >= 2 {
+		conds = append(conds, i18n.T("composition.win.scaling", strings.Join(scaling, ", ")))
+	}
+	if peel := traits[staticdata.TraitPeel]; len(peel) > 0 && comp.Archetype != ArchetypeDive {
+		conds = append(conds, i18n.T("composition.win.peel", strings.Join(peel, ", ")))
+	}
+	return conds
+}
+
+// compositionWarnings takımın zayıf yönlerini döner
+func compositionWarnings(comp TeamComposition, known int) []string {
+	var warnings []string
+	switch {
+	case comp.Damage.Physical >= compositionSkewedDamage:
+		warnings = append(warnings, i18n.T("composition.warn.physical", comp.Damage.Physical*100))
+	case comp.Damage.Magic >= compositionSkewedDamage:
+		warnings = append(warnings, i18n.T("composition.warn.magic", comp.Damage.Magic*100))
+	}
+	if comp.Frontline == 0 && known >= 3 {
+		warnings = append(warnings, i18n.T("composition.warn.frontline"))
+	}
+	if len(comp.Engage) == 0 && known >= 3 {
+		warnings = append(warnings, i18n.T("composition.warn.engage"))
+	}
+	return warnings
+}
+
+// AnalyzeCompositions takım -> şampiyon listesinden iki takımın analizini yapar.
+// Takımlar ORDER, CHAOS sırasıyla döner.
+func AnalyzeCompositions(data *staticdata.GameData, teams map[string][]string, localTeam string) *CompositionAnalysis {
+	names := make([]string, 0, len(teams))
+	for team := range teams {
+		names = append(names, team)
+	}
+	sort.Strings(names)
+	// "CHAOS" < "ORDER"; mavi takım önce gelsin
+	sort.SliceStable(names, func(i, j int) bool { return names[i] == "ORDER" && names[j] != "ORDER" })
+
+	analysis := &CompositionAnalysis{LocalTeam: localTeam}
+	for _, team := range names {
+		analysis.Teams = append(analysis.Teams, AnalyzeComposition(data, team, teams[team]))
+	}
+	return analysis
+}
+
+// LiveCompositions oyun içi oyuncu listesinden kompozisyon analizi yapar
+func LiveCompositions(data *staticdata.GameData, players []lcu.LivePlayer, localTeam string) *CompositionAnalysis {
+	teams := make(map[string][]string)
+	for _, p := range players {
+		name := p.ChampionName
+		if ch, ok := LiveChampion(data, p); ok {
+			name = ch.Name
+		}
+		teams[p.Team] = append(teams[p.Team], name)
+	}
+	return AnalyzeCompositions(data, teams, localTeam)
+}
+
+// LiveChampion oyuncunun şampiyonunu yüklü yamada bulur. Live Client'ın ham adı
+// ("game_character_displayname_MonkeyKing") Data Dragon kimliğini içerir ve istemci
+// dilinden bağımsızdır; yoksa görünen ada bakılır. Yama yüklenmediyse (data nil) false döner.
+func LiveChampion(data *staticdata.GameData, p lcu.LivePlayer) (*staticdata.Champion, bool) {
+	if data == nil {
+		return nil, false
+	}
+	if i := strings.LastIndex(p.RawChampionName, "_"); i >= 0 && i < len(p.RawChampionName)-1 {
+		if ch, ok := data.ChampionByName(p.RawChampionName[i+1:]); ok {
+			return ch, true
+		}
+	}
+	return data.ChampionByName(p.ChampionName)
+}
+
+// ChampSelectCompositions şampiyon seçimindeki kilitlenen/ön seçilen şampiyonlardan
+// analiz yapar. Henüz açıklanmamış rakip seçimleri (ID 0) atlanır.
+func ChampSelectCompositions(data *staticdata.GameData, session *lcu.LolChampSelectChampSelectSession) *CompositionAnalysis {
+	teams := make(map[string][]string)
+	localTeam := ""
+	add := func(players []lcu.LolChampSelectChampSelectPlayerSelection, local bool) {
+		for _, p := range players {
+			team := champSelectTeam(p.Team)
+			if local && localTeam == "" {
+				localTeam = team
+			}
+			if _, ok := teams[team]; !ok {
+				teams[team] = nil
+			}
+			if ch, ok := data.Champion(int(p.ChampionID)); ok {
+				teams[team] = append(teams[team], ch.Name)
+			}
+		}
+	}
+	add(session.MyTeam, true)
+	add(session.TheirTeam, false)
+	return AnalyzeCompositions(data, teams, localTeam)
+}
+
+// champSelectTeam şampiyon seçimindeki takım numarasını (1 mavi, 2 kırmızı) oyun içi ada çevirir
+func champSelectTeam(team int32) string {
+	if team == 2 {
+		return "CHAOS"
+	}
+	return "ORDER"
+}
+
+// PromptSummary analizi AI istemi için İngilizce kısa metne çevirir
+func (c *CompositionAnalysis) PromptSummary() string {
+	if c == nil {
+		return ""
+	}
+	var b strings.Builder
+	for _, t := range c.Teams {
+		if len(t.Champions) == 0 {
+			continue
+		}
+		label := t.Team
+		switch {
+		case c.LocalTeam == "":
+		case t.Team == c.LocalTeam:
+			label = "My team (" + t.Team + ")"
+		default:
+			label = "Enemy team (" + t.Team + ")"
+		}
+		fmt.Fprintf(&b, "%s: %s. Damage AD %d%% / AP %d%% / true %d%%, frontline %d, hard CC %d, archetype %s",
+			label, strings.Join(t.Champions, ", "),
+			int(math.Round(t.Damage.Physical*100)), int(math.Round(t.Damage.Magic*100)), int(math.Round(t.Damage.True*100)),
+			t.Frontline, t.HardCC, t.Archetype)
+		if len(t.Engage) > 0 {
+			fmt.Fprintf(&b, ", engage: %s", strings.Join(t.Engage, ", "))
+		}
+		if len(t.Disengage) > 0 {
+			fmt.Fprintf(&b, ", disengage: %s", strings.Join(t.Disengage, ", "))
+		}
+		b.WriteString(".\n")
+	}
+	return strings.TrimSpace(b.String())
+}
@@ -0,0 +1,320 @@
+package lol
+
+import (
+	"log"
+	"sort"
+	"sync"
+
+	"lol-helper/internal/lcu"
+)
+
+// Event oyun içi olay. Somut tipler aşağıdaki *Event struct'larıdır.
+type Event interface {
+	ID() int
+	Name() string
+	Time() float64 // Oyun başından itibaren saniye
+}
+
+// EventBase tüm olayların ortak alanları
+type EventBase struct {
+	EventID   int
+	EventName string
+	EventTime float64
+}
+
+func (e EventBase) ID() int       { return e.EventID }
+func (e EventBase) Name() string  { return e.EventName }
+func (e EventBase) Time() float64 { return e.EventTime }
+
+// GameStartEvent oyun başladı
+type GameStartEvent struct{ EventBase }
+
+// MinionsSpawningEvent minyonlar çıkmaya başladı
+type MinionsSpawningEvent struct{ EventBase }
+
+// FirstBloodEvent ilk kan
+type FirstBloodEvent struct {
+	EventBase
+	Recipient string
+}
+
+// ChampionKillEvent şampiyon öldürüldü
+type ChampionKillEvent struct {
+	EventBase
+	Killer    string
+	Victim    string
+	Assisters []string
+}
+
+// MultikillEvent çoklu öldürme (2: double, 3: triple, ...)
+type MultikillEvent struct {
+	EventBase
+	Killer     string
+	KillStreak int
+}
+
+// AceEvent bir takımın tüm oyuncuları öldü
+type AceEvent struct {
+	EventBase
+	Acer      string
+	AcingTeam string // ORDER, CHAOS
+}
+
+// DragonKillEvent ejderha öldürüldü
+type DragonKillEvent struct {
+	EventBase
+	Killer     string
+	DragonType string // Fire, Water, Earth, Air, Hextech, Chemtech, Elder
+	Stolen     bool
+	Assisters  []string
+}
+
+// HeraldKillEvent Vadi Alameti öldürüldü
+type HeraldKillEvent struct {
+	EventBase
+	Killer    string
+	Stolen    bool
+	Assisters []string
+}
+
+// HordeKillEvent Hiçlik Kurtçuğu öldürüldü
+type HordeKillEvent struct {
+	EventBase
+	Killer    string
+	Stolen    bool
+	Assisters []string
+}
+
+// BaronKillEvent Baron Nashor öldürüldü
+type BaronKillEvent struct {
+	EventBase
+	Killer    string
+	Stolen    bool
+	Assisters []string
+}
+
+// TurretKilledEvent kule yıkıldı
+type TurretKilledEvent struct {
+	EventBase
+	Killer    string
+	Turret    string // Turret_T2_C_05_A gibi
+	Assisters []string
+}
+
+// InhibKilledEvent inhibitör yıkıldı
+type InhibKilledEvent struct {
+	EventBase
+	Killer    string
+	Inhib     string // Barracks_T1_L1 gibi
+	Assisters []string
+}
+
+// InhibRespawningSoonEvent inhibitör yakında geri gelecek
+type InhibRespawningSoonEvent struct {
+	EventBase
+	Inhib string
+}
+
+// InhibRespawnedEvent inhibitör geri geldi
+type InhibRespawnedEvent struct {
+	EventBase
+	Inhib string
+}
+
+// FirstBrickEvent ilk kule
+type FirstBrickEvent struct {
+	EventBase
+	Killer string
+}
+
+// GameEndEvent oyun bitti
+type GameEndEvent struct {
+	EventBase
+	Result string // Win, Lose
+}
+
+// UnknownEvent henüz tiplenmemiş olaylar için ham veri
+type UnknownEvent struct {
+	EventBase
+	Raw lcu.LiveEvent
+}
+
+// decodeEvent Live Client olayını tipli Go değerine çevirir
+func decodeEvent(e lcu.LiveEvent) Event {
+	base := EventBase{EventID: e.EventID, EventName: e.EventName, EventTime: e.EventTime}
+	stolen := e.Stolen == "True"
+
+	switch e.EventName {
+	case "GameStart":
+		return GameStartEvent{base}
+	case "MinionsSpawning":
+		return MinionsSpawningEvent{base}
+	case "FirstBlood":
+		return FirstBloodEvent{EventBase: base, Recipient: e.Recipient}
+	case "ChampionKill":
+		return ChampionKillEvent{EventBase: base, Killer: e.KillerName, Victim: e.VictimName, Assisters: e.Assisters}
+	case "Multikill":
+		return MultikillEvent{EventBase: base, Killer: e.KillerName, KillStreak: e.KillStreak}
+	case "Ace":
+		return AceEvent{EventBase: base, Acer: e.Acer, AcingTeam: e.AcingTeam}
+	case "DragonKill":
+		return DragonKillEvent{EventBase: base, Killer: e.KillerName, DragonType: e.DragonType, Stolen: stolen, Assisters: e.Assisters}
+	case "HeraldKill":
+		return HeraldKillEvent{EventBase: base, Killer: e.KillerName, Stolen: stolen, Assisters: e.Assisters}
+	case "HordeKill":
+		return HordeKillEvent{EventBase: base, Killer: e.KillerName, Stolen: stolen, Assisters: e.Assisters}
+	case "BaronKill":
+		return BaronKillEvent{EventBase: base, Killer: e.KillerName, Stolen: stolen, Assisters: e.Assisters}
+	case "TurretKilled":
+		return TurretKilledEvent{EventBase: base, Killer: e.KillerName, Turret: e.TurretKilled, Assisters: e.Assisters}
+	case "InhibKilled":
+		return InhibKilledEvent{EventBase: base, Killer: e.KillerName, Inhib: e.InhibKilled, Assisters: e.Assisters}
+	case "InhibRespawningSoon":
+		return InhibRespawningSoonEvent{EventBase: base, Inhib: e.InhibRespawningSoon}
+	case "InhibRespawned":
+		return InhibRespawnedEvent{EventBase: base, Inhib: e.InhibRespawned}
+	case "FirstBrick":
+		return FirstBrickEvent{EventBase: base, Killer: e.KillerName}
+	case "GameEnd":
+		return GameEndEvent{EventBase: base, Result: e.Result}
+	}
+	return UnknownEvent{EventBase: base, Raw: e}
+}
+
+// EventTracker Live Client olaylarını son görülen EventID'den itibaren
+// çeker, tekrarları eler ve abonelere yayınlar.
+type EventTracker struct {
+	liveClient *lcu.LiveClient
+
+	mu           sync.Mutex
+	nextID       int
+	seen         map[int]bool
+	lastGameTime float64
+	history      []Event
+
+	subMu       sync.RWMutex
+	subscribers []subscriber // Abone olma sırasıyla çağrılır
+	nextSubID   int
+}
+
+// subscriber kimliğiyle birlikte saklanan olay dinleyicisi
+type subscriber struct {
+	id int
+	fn func(Event)
+}
+
+// NewEventTracker yeni bir olay takipçisi oluşturur
+func NewEventTracker(liveClient *lcu.LiveClient) *EventTracker {
+	return &EventTracker{
+		liveClient: liveClient,
+		seen:       make(map[int]bool),
+	}
+}
+
+// Subscribe yeni olaylar için bir dinleyici ekler. Dönen fonksiyon aboneliği iptal eder.
+// Dinleyiciler polling goroutine'inden, abone oldukları sırayla çağrılır; uzun sürecek
+// işleri kendileri ayırmalıdır.
+func (t *EventTracker) Subscribe(fn func(Event)) func() {
+	t.subMu.Lock()
+	defer t.subMu.Unlock()
+
+	id := t.nextSubID
+	t.nextSubID++
+	t.subscribers = append(t.subscribers, subscriber{id: id, fn: fn})
+
+	return func() {
+		t.subMu.Lock()
+		defer t.subMu.Unlock()
+		for i, sub := range t.subscribers {
+			if sub.id == id {
+				t.subscribers = append(t.subscribers[:i:i], t.subscribers[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// History bu oyunda şimdiye kadar görülen olayları döner
+func (t *EventTracker) History() []Event {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]Event(nil), t.history...)
+}
+
+// Reset yeni oyun için takipçiyi sıfırlar (aboneler korunur)
+func (t *EventTracker) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.reset()
+}
+
+func (t *EventTracker) reset() {
+	t.nextID = 0
+	t.seen = make(map[int]bool)
+	t.lastGameTime = 0
+	t.history = nil
+}
+
+// Poll yeni olayları çeker ve yayınlar. gameTime, oyun süresinin geri
+// gitmesinden yeni bir oyunun başladığını anlamak için kullanılır.
+func (t *EventTracker) Poll(gameTime float64) error {
+	t.mu.Lock()
+	// Oyun süresi belirgin şekilde geri gittiyse yeni oyundayız
+	if gameTime+5 < t.lastGameTime {
+		t.reset()
+	}
+	t.lastGameTime = gameTime
+	from := t.nextID
+	t.mu.Unlock()
+
+	raw, err := t.liveClient.GetEventData(from)
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(raw, func(i, j int) bool { return raw[i].EventID < raw[j].EventID })
+
+	var fresh []Event
+	t.mu.Lock()
+	for _, e := range raw {
+		// Yeniden bağlanmalarda API aynı olayları tekrar döndürebilir
+		if t.seen[e.EventID] {
+			continue
+		}
+		t.seen[e.EventID] = true
+		if e.EventID >= t.nextID {
+			t.nextID = e.EventID + 1
+		}
+
+		ev := decodeEvent(e)
+		t.history = append(t.history, ev)
+		fresh = append(fresh, ev)
+	}
+	t.mu.Unlock()
+
+	for _, ev := range fresh {
+		t.publish(ev)
+	}
+	return nil
+}
+
+func (t *EventTracker) publish(ev Event) {
+	t.subMu.RLock()
+	subs := make([]func(Event), 0, len(t.subscribers))
+	for _, sub := range t.subscribers {
+		subs = append(subs, sub.fn)
+	}
+	t.subMu.RUnlock()
+
+	for _, fn := range subs {
+		func() {
+			// Bir dinleyicideki hata diğerlerini etkilemesin
+			defer func() {
+				if r := recover(); r != nil {
+					log.Printf("Olay dinleyicisi hatası (%s): %v", ev.Name(), r)
+				}
+			}()
+			fn(ev)
+		}()
+	}
+}
@@ -0,0 +1,145 @@
+package lol
+
+import (
+	"sync"
+
+	"lol-helper/internal/lcu"
+)
+
+// goldSampleInterval altın geçmişine kaç oyun saniyesinde bir örnek ekleneceği
+const goldSampleInterval = 10
+
+// lanePositions koridor karşılaştırması için pozisyon sırası
+var lanePositions = []string{"TOP", "JUNGLE", "MIDDLE", "BOTTOM", "UTILITY"}
+
+// ItemCatalog item fiyat ve tür bilgisini sağlayan kaynak
+type ItemCatalog interface {
+	// TotalCost item'ın bileşenleri dahil toplam fiyatı
+	TotalCost(itemID int) (int, bool)
+	// IsLegendary item'ın tamamlanmış (başka bir item'a dönüşmeyen) efsanevi item olup olmadığı
+	IsLegendary(itemID int) bool
+}
+
+// GoldSample belirli bir oyun anındaki takım item değerleri
+type GoldSample struct {
+	GameTime float64
+	Order    int
+	Chaos    int
+}
+
+// Diff mavi takımın kırmızı takıma göre altın farkı
+func (s GoldSample) Diff() int {
+	return s.Order - s.Chaos
+}
+
+// LaneGoldDiff aynı pozisyondaki iki oyuncunun item değeri karşılaştırması
+type LaneGoldDiff struct {
+	Position   string
+	OrderName  string
+	ChaosName  string
+	OrderValue int
+	ChaosValue int
+}
+
+// GoldEstimate Live Client rakip altınını vermediği için item değerlerinden yapılan tahmin
+type GoldEstimate struct {
+	PlayerValues map[string]int // Oyuncu adı -> envanter değeri
+	TeamValues   map[string]int // Takım -> toplam envanter değeri
+	Lanes        []LaneGoldDiff
+	History      []GoldSample
+}
+
+// GoldEstimator oyuncuların envanter değerini hesaplar ve zaman içinde kaydeder
+type GoldEstimator struct {
+	mu      sync.Mutex
+	catalog ItemCatalog
+	history []GoldSample
+}
+
+// NewGoldEstimator yeni bir altın tahmincisi oluşturur
+func NewGoldEstimator() *GoldEstimator {
+	return &GoldEstimator{}
+}
+
+// SetCatalog item fiyat kaynağını ayarlar
+func (g *GoldEstimator) SetCatalog(catalog ItemCatalog) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.catalog = catalog
+}
+
+// itemValue oyuncunun envanterindeki itemlerin toplam değeri
+func itemValue(p lcu.LivePlayer, catalog ItemCatalog) int {
+	total := 0
+	for _, item := range p.Items {
+		count := item.Count
+		if count < 1 {
+			count = 1
+		}
+
+		// Katalog yoksa veya item bilinmiyorsa Live Client fiyatına düş
+		cost := item.Price
+		if catalog != nil {
+			if c, ok := catalog.TotalCost(item.ItemID); ok {
+				cost = c
+			}
+		}
+		total += cost * count
+	}
+	return total
+}
+
+// Update oyuncu listesinden yeni tahmin üretir ve gerekiyorsa geçmişe örnek ekler
+func (g *GoldEstimator) Update(players []lcu.LivePlayer, gameTime float64) GoldEstimate {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	est := GoldEstimate{
+		PlayerValues: make(map[string]int, len(players)),
+		TeamValues:   make(map[string]int, 2),
+	}
+
+	byPosition := make(map[string]map[string]lcu.LivePlayer)
+	for _, p := range players {
+		value := itemValue(p, g.catalog)
+		est.PlayerValues[p.SummonerName] = value
+		est.TeamValues[p.Team] += value
+
+		if p.Position != "" {
+			if byPosition[p.Position] == nil {
+				byPosition[p.Position] = make(map[string]lcu.LivePlayer, 2)
+			}
+			byPosition[p.Position][p.Team] = p
+		}
+	}
+
+	for _, pos := range lanePositions {
+		order, okOrder := byPosition[pos]["ORDER"]
+		chaos, okChaos := byPosition[pos]["CHAOS"]
+		if !okOrder || !okChaos {
+			continue
+		}
+		est.Lanes = append(est.Lanes, LaneGoldDiff{
+			Position:   pos,
+			OrderName:  order.ChampionName,
+			ChaosName:  chaos.ChampionName,
+			OrderValue: est.PlayerValues[order.SummonerName],
+			ChaosValue: est.PlayerValues[chaos.SummonerName],
+		})
+	}
+
+	// Oyun süresi geri gittiyse yeni oyun başlamıştır
+	if n := len(g.history); n > 0 && gameTime < g.history[n-1].GameTime {
+		g.history = nil
+	}
+	if n := len(g.history); n == 0 || gameTime-g.history[n-1].GameTime >= goldSampleInterval {
+		g.history = append(g.history, GoldSample{
+			GameTime: gameTime,
+			Order:    est.TeamValues["ORDER"],
+			Chaos:    est.TeamValues["CHAOS"],
+		})
+	}
+	est.History = append([]GoldSample(nil), g.history...)
+
+	return est
+}
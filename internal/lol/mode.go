@@ -0,0 +1,151 @@
+package lol
+
+import (
+	"fmt"
+	"strings"
+
+	"lol-helper/internal/i18n"
+	"lol-helper/internal/lcu"
+	"lol-helper/internal/staticdata"
+)
+
+// GameMode oynanan oyun modu
+type GameMode string
+
+const (
+	ModeClassic GameMode = "CLASSIC" // Sihirdar Vadisi
+	ModeARAM    GameMode = "ARAM"    // Sonsuz Uçurum
+	ModeArena   GameMode = "CHERRY"  // Arena (2v2v2v2)
+	ModeTFT     GameMode = "TFT"     // Teamfight Tactics
+)
+
+const (
+	riftMapID         = 11
+	howlingAbyssMapID = 12
+	aramQueueID       = 450
+	arenaMapID        = 30
+	arenaQueueID      = 1700
+	tftMapID          = 22
+)
+
+// tftQueueIDs normal, dereceli, Hyper Roll, Double Up ve eğitim TFT sıraları
+var tftQueueIDs = map[int]bool{1090: true, 1100: true, 1130: true, 1160: true, 1220: true}
+
+// DetectMode Live Client/LCU'dan gelen mod adı, harita ve sıra numarasından oyun
+// modunu bulur. Özel oyunlarda mod adı boş gelebildiği için harita da kontrol edilir.
+func DetectMode(gameMode string, mapID, queueID int) GameMode {
+	if strings.EqualFold(gameMode, string(ModeTFT)) || mapID == tftMapID || tftQueueIDs[queueID] {
+		return ModeTFT
+	}
+	if strings.EqualFold(gameMode, string(ModeArena)) || mapID == arenaMapID || queueID == arenaQueueID {
+		return ModeArena
+	}
+	if strings.EqualFold(gameMode, string(ModeARAM)) || mapID == howlingAbyssMapID || queueID == aramQueueID {
+		return ModeARAM
+	}
+	return ModeClassic
+}
+
+// Label modun arayüzde gösterilen adı
+func (m GameMode) Label() string {
+	switch m {
+	case ModeARAM:
+		return "ARAM"
+	case ModeArena:
+		return "Arena"
+	case ModeTFT:
+		return "TFT"
+	case ModeClassic, "":
+		return i18n.T("mode.classic")
+	}
+	return string(m)
+}
+
+// HasLanes modda koridor/rol ayrımı (ve dolayısıyla rol hedefleri) olup olmadığı
+func (m GameMode) HasLanes() bool {
+	return m != ModeARAM && m != ModeArena && m != ModeTFT
+}
+
+// HasObjectives modda ejderha, baron ve herald gibi orman hedefleri olup olmadığı
+func (m GameMode) HasObjectives() bool {
+	return m != ModeARAM && m != ModeArena && m != ModeTFT
+}
+
+// MapID modun oynandığı haritanın numarası (Data Dragon item haritalarıyla aynı)
+func (m GameMode) MapID() int {
+	switch m {
+	case ModeARAM:
+		return howlingAbyssMapID
+	case ModeArena:
+		return arenaMapID
+	case ModeTFT:
+		return tftMapID
+	}
+	return riftMapID
+}
+
+// ChampSelectBench ARAM şampiyon seçimindeki yedek kulübesi ve yeniden seçim hakkı
+type ChampSelectBench struct {
+	Enabled          bool
+	Champions        []string
+	AllowRerolling   bool
+	RerollsRemaining int
+}
+
+// newChampSelectBench oturumdaki yedek kulübesi ID'lerini şampiyon isimlerine çevirir
+func newChampSelectBench(data *staticdata.GameData, session *lcu.LolChampSelectChampSelectSession) ChampSelectBench {
+	bench := ChampSelectBench{
+		Enabled:          session.BenchEnabled,
+		AllowRerolling:   session.AllowRerolling,
+		RerollsRemaining: int(session.RerollsRemaining),
+	}
+	for _, b := range session.BenchChampions {
+		if ch, ok := data.Champion(int(b.ChampionID)); ok {
+			bench.Champions = append(bench.Champions, ch.Name)
+		}
+	}
+	return bench
+}
+
+// localChampSelectChampion yerel oyuncunun seçtiği (veya ARAM'da atanan) şampiyonu bulur
+func localChampSelectChampion(data *staticdata.GameData, session *lcu.LolChampSelectChampSelectSession) string {
+	for _, p := range session.MyTeam {
+		if p.CellID != session.LocalPlayerCellID {
+			continue
+		}
+		if ch, ok := data.Champion(int(p.ChampionID)); ok {
+			return ch.Name
+		}
+	}
+	return ""
+}
+
+// ARAMBalanceSummary şampiyonların ARAM denge ayarlarını AI istemi için İngilizce
+// kısa metne çevirir. Ayarı olmayan şampiyonlar atlanır.
+func ARAMBalanceSummary(data *staticdata.GameData, champions []string) string {
+	var parts []string
+	for _, name := range champions {
+		ch, ok := data.ChampionByName(name)
+		if !ok || ch.ARAM == nil {
+			continue
+		}
+		var mods []string
+		for _, m := range []struct {
+			label string
+			value float64
+		}{
+			{"damage dealt", ch.ARAM.DamageDealt},
+			{"damage taken", ch.ARAM.DamageTaken},
+			{"healing", ch.ARAM.Healing},
+			{"shielding", ch.ARAM.Shielding},
+		} {
+			if m.value != 0 && m.value != 1 {
+				mods = append(mods, fmt.Sprintf("%s %+.0f%%", m.label, (m.value-1)*100))
+			}
+		}
+		if len(mods) > 0 {
+			parts = append(parts, fmt.Sprintf("%s (%s)", ch.Name, strings.Join(mods, ", ")))
+		}
+	}
+	return strings.Join(parts, "; ")
+}
@@ -1,17 +1,112 @@
 package lol
 
-import "lol-helper/internal/lcu"
+import (
+	"lol-helper/internal/lcu"
+	"lol-helper/internal/staticdata"
+)
 
 // GameState oyun durumu
 type GameState struct {
 	Phase       string
 	Champion    string
 	Items       []string
+	ItemIDs     []int // Items ile aynı sırada envanterdeki item ID'leri
 	Gold        int
 	EnemyChamps []string
 	GameTime    int
 	IsConnected bool
 	AllPlayers  []lcu.LivePlayer
+	LocalTeam   string // Yerel oyuncunun takımı (ORDER, CHAOS)
+
+	// Mode oyun modu (Sihirdar Vadisi, ARAM); ARAM'da koridor ve rol hedefleri yoktur
+	Mode GameMode
+	// Bench ARAM şampiyon seçimindeki yedek kulübesi ve yeniden seçim hakkı
+	Bench ChampSelectBench
+	// Arena Arena'da ikililer, tur ve seçilen augmentler; diğer modlarda nil
+	Arena *ArenaState
+	// TFT TFT'de lobi ve geçmiş sıralamalar; diğer modlarda nil
+	TFT *TFTState
+
+	// Patch item, şampiyon ve rün verisinin yüklendiği Data Dragon yaması; henüz yüklenmediyse boş
+	Patch string
+
+	// Spectator oyun izleniyor (izleyici modu veya tekrar); ActivePlayer bu durumda nil
+	Spectator bool
+	// Perspective AI koçunun baktığı oyuncu: kendi oyunumuzda yerel oyuncu, izlerken kullanıcının seçtiği oyuncu
+	Perspective string
+
+	// Objectives ejderha, baron, herald, kurtçuk ve inhibitör zamanlayıcıları
+	Objectives ObjectiveState
+
+	// GoldEstimate item değerlerinden tahmin edilen oyuncu/takım altını ve geçmişi
+	GoldEstimate GoldEstimate
+
+	// Notifications item tamamlama ve seviye güç artışı bildirimleri (eskiden yeniye)
+	Notifications []Notification
+
+	// ActivePlayer yerel oyuncunun yetenek, istatistik ve rün bilgisi (sadece oyun içinde)
+	ActivePlayer *lcu.LiveActivePlayer
+
+	// GameID oyunun kimliği (LCU'dan alınamazsa oyuncu ve moddan üretilen "local-<özet>")
+	GameID string
+
+	// Stats yerel oyuncunun bu oyundaki istatistik geçmişi
+	Stats *StatRecord
+
+	// Skills yetenek yükseltme sırası, sıradaki yetenek ve harcanmamış puan
+	Skills SkillState
+
+	// Benchmarks oyuncu adı -> CS/dk, görüş/dk ve skor katılımının rol hedefiyle karşılaştırması
+	Benchmarks map[string]BenchmarkResult
+
+	// Composition iki takımın hasar dağılımı, ön hat, CC ve arketip analizi
+	Composition *CompositionAnalysis
+
+	// SuggestedItems AI'ın önerdiği item adlarının yüklü yamadaki karşılıkları (öneri sırasıyla)
+	SuggestedItems []ItemSuggestion
+
+	// Purchases AI'ın önerdiği itemlerden şu anki altın ve envanterle alınabilecekler
+	Purchases []PurchasePlan
+}
+
+// ItemSuggestion AI'ın yazdığı bir item adı ve yamadaki karşılığı
+type ItemSuggestion struct {
+	Name       string  // AI'ın yazdığı ad
+	ItemID     int     // Çözülemediyse 0
+	ItemName   string  // Yamadaki adı
+	Confidence float64 // 0-1; 1 tam isim veya takma ad
+	Unresolved bool    // Yama yüklü ama ad hiçbir iteme yeterince benzemiyor
+}
+
+// KnownRuneIDs oyuncunun bilinen rün ID'lerini döner. Yerel oyuncunun tüm
+// rün sayfası görünür, diğer oyuncular için sadece keystone bilinir.
+func (g *GameState) KnownRuneIDs(p lcu.LivePlayer) []int {
+	if g.ActivePlayer != nil && p.SummonerName == g.ActivePlayer.SummonerName {
+		ids := []int{g.ActivePlayer.FullRunes.Keystone.ID}
+		for _, r := range g.ActivePlayer.FullRunes.GeneralRunes {
+			ids = append(ids, r.ID)
+		}
+		return ids
+	}
+	return []int{p.Runes.Keystone.ID}
+}
+
+// RunePage oyuncunun rün sayfasını katalog kayıtlarına çevirir. Yerel oyuncunun
+// tüm sayfası ve stat shard'ları, diğer oyuncuların keystone ve ağaçları bilinir.
+func (g *GameState) RunePage(data *staticdata.GameData, p lcu.LivePlayer) staticdata.RunePage {
+	if g.ActivePlayer != nil && p.SummonerName == g.ActivePlayer.SummonerName {
+		full := g.ActivePlayer.FullRunes
+		perks := make([]int, 0, len(full.GeneralRunes))
+		for _, r := range full.GeneralRunes {
+			perks = append(perks, r.ID)
+		}
+		shards := make([]int, 0, len(full.StatRunes))
+		for _, r := range full.StatRunes {
+			shards = append(shards, r.ID)
+		}
+		return data.ResolveRunePage(full.Keystone.ID, full.PrimaryRuneTree.ID, full.SecondaryRuneTree.ID, perks, shards)
+	}
+	return data.ResolveRunePage(p.Runes.Keystone.ID, p.Runes.PrimaryRuneTree.ID, p.Runes.SecondaryRuneTree.ID, nil, nil)
 }
 
 // Recommendation AI önerisi
@@ -43,14 +138,24 @@ func NewHelperState() *HelperState {
 	}
 }
 
-// UpdateFromLCU LCU verisiyle durumu günceller
-func (s *HelperState) UpdateFromLCU(gameData *lcu.GameData, summoner *lcu.Summoner) {
+// UpdateFromLCU LCU verisiyle durumu günceller. data yüklü yamanın verisidir;
+// henüz yüklenmediyse şampiyon seçimi analizi atlanır.
+func (s *HelperState) UpdateFromLCU(gameData *lcu.GameData, summoner *lcu.Summoner, data *staticdata.GameData) {
 	s.Game.Phase = gameData.Phase
 	s.Game.IsConnected = true
+	s.Game.Mode = DetectMode(gameData.GameMode, gameData.MapID, gameData.QueueID)
 
-	if gameData.Phase == "ChampSelect" && gameData.ChampSelect != nil {
-		// Champ select mantığı buraya eklenebilir
-		// Şu anlık basit tutuyoruz
+	if gameData.Phase == "ChampSelect" && gameData.ChampSelect != nil && data != nil {
+		s.Game.Composition = ChampSelectCompositions(data, gameData.ChampSelect)
+		s.Game.Champion = localChampSelectChampion(data, gameData.ChampSelect)
+		s.Game.Bench = newChampSelectBench(data, gameData.ChampSelect)
+		s.Game.LocalTeam = s.Game.Composition.LocalTeam
+		s.Game.EnemyChamps = nil
+		for _, team := range s.Game.Composition.Teams {
+			if team.Team != s.Game.LocalTeam {
+				s.Game.EnemyChamps = append(s.Game.EnemyChamps, team.Champions...)
+			}
+		}
 	} else if gameData.Phase == "InProgress" && gameData.InGame != nil {
 		s.Game.GameTime = int(gameData.InGame.GameTime)
 		// Oyuncu ve item bilgileri buraya eklenecek
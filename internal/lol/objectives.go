@@ -0,0 +1,286 @@
+package lol
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Objective tarafsız hedef türü
+type Objective string
+
+const (
+	ObjectiveDragon    Objective = "Dragon"
+	ObjectiveElder     Objective = "Elder"
+	ObjectiveBaron     Objective = "Baron"
+	ObjectiveHerald    Objective = "Herald"
+	ObjectiveGrubs     Objective = "Grubs"
+	ObjectiveInhibitor Objective = "Inhibitor"
+)
+
+// Sihirdar Vadisi doğma/yeniden doğma süreleri (saniye)
+const (
+	dragonFirstSpawn   = 5 * 60
+	dragonRespawn      = 5 * 60
+	elderRespawn       = 6 * 60
+	dragonSoulCount    = 4
+	baronFirstSpawn    = 20 * 60
+	baronRespawn       = 6 * 60
+	heraldSpawn        = 14 * 60
+	heraldDespawn      = 19*60 + 45
+	grubsFirstSpawn    = 5 * 60
+	grubsRespawn       = 4 * 60
+	grubsPerWave       = 3
+	grubsLastWaveStart = 13*60 + 45 // Bu süreden sonra yeni dalga çıkmaz
+	inhibitorRespawn   = 5 * 60
+)
+
+// ObjectiveTimer tek bir hedefin zamanlayıcısı
+type ObjectiveTimer struct {
+	Objective   Objective
+	Key         string  // Aynı türden birden fazla zamanlayıcıyı ayırır (inhibitörler)
+	NextSpawn   float64 // Oyun saniyesi; Alive ise doğduğu an
+	Alive       bool    // Hedef şu an haritada
+	LastTakenBy string  // ORDER, CHAOS veya bilinmiyorsa boş
+	Detail      string  // Son alınan ejderhanın türü, inhibitörün koridoru vb.
+}
+
+// Remaining doğmasına kalan süre (saniye). Hedef haritadaysa 0 döner.
+func (t ObjectiveTimer) Remaining(gameTime float64) float64 {
+	if t.Alive || gameTime >= t.NextSpawn {
+		return 0
+	}
+	return t.NextSpawn - gameTime
+}
+
+// ObjectiveState hedef zamanlayıcıları ve takım bazında ejderha durumu
+type ObjectiveState struct {
+	Timers      []ObjectiveTimer
+	DragonCount map[string]int      // Takım -> alınan ejderha (Elder hariç)
+	DragonTypes map[string][]string // Takım -> alınan ejderha türleri
+	SoulTeam    string              // Ejderha ruhunu alan takım
+}
+
+// ObjectiveTracker olay akışından tarafsız hedeflerin durumunu çıkarır
+type ObjectiveTracker struct {
+	mu sync.Mutex
+
+	teamOf map[string]string // Oyuncu adı -> takım
+
+	dragonNext    float64
+	dragonLast    string
+	dragonType    string
+	dragonCount   map[string]int
+	dragonTypes   map[string][]string
+	soulTeam      string
+	baronNext     float64
+	baronLast     string
+	heraldDone    bool
+	grubsNext     float64
+	grubsKilled   int
+	grubsWave     int
+	grubsLast     string
+	grubsDone     bool
+	inhibitors    map[string]float64 // Inhibitör adı -> geri gelme zamanı
+	inhibitorTeam map[string]string  // Inhibitör adı -> yıkan takım
+}
+
+// NewObjectiveTracker yeni bir hedef takipçisi oluşturur
+func NewObjectiveTracker() *ObjectiveTracker {
+	t := &ObjectiveTracker{teamOf: make(map[string]string)}
+	t.reset()
+	return t
+}
+
+func (t *ObjectiveTracker) reset() {
+	t.dragonNext = dragonFirstSpawn
+	t.dragonLast = ""
+	t.dragonType = ""
+	t.dragonCount = make(map[string]int)
+	t.dragonTypes = make(map[string][]string)
+	t.soulTeam = ""
+	t.baronNext = baronFirstSpawn
+	t.baronLast = ""
+	t.heraldDone = false
+	t.grubsNext = grubsFirstSpawn
+	t.grubsKilled = 0
+	t.grubsWave = 1
+	t.grubsLast = ""
+	t.grubsDone = false
+	t.inhibitors = make(map[string]float64)
+	t.inhibitorTeam = make(map[string]string)
+}
+
+// SetTeams olaylardaki oyuncu isimlerini takıma çevirmek için oyuncu listesini günceller
+func (t *ObjectiveTracker) SetTeams(teamOf map[string]string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.teamOf = teamOf
+}
+
+// HandleEvent EventTracker aboneliği olarak kullanılır
+func (t *ObjectiveTracker) HandleEvent(ev Event) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	switch e := ev.(type) {
+	case GameStartEvent:
+		t.reset()
+
+	case DragonKillEvent:
+		team := t.teamOf[e.Killer]
+		if e.DragonType == "Elder" {
+			t.dragonNext = e.EventTime + elderRespawn
+			t.dragonLast = team
+			t.dragonType = e.DragonType
+			return
+		}
+
+		t.dragonLast = team
+		t.dragonType = e.DragonType
+		if team != "" {
+			t.dragonCount[team]++
+			t.dragonTypes[team] = append(t.dragonTypes[team], e.DragonType)
+			if t.soulTeam == "" && t.dragonCount[team] >= dragonSoulCount {
+				t.soulTeam = team
+			}
+		}
+		// Ruh alındıktan sonra sadece Elder Ejderha çıkar
+		if t.soulTeam != "" {
+			t.dragonNext = e.EventTime + elderRespawn
+		} else {
+			t.dragonNext = e.EventTime + dragonRespawn
+		}
+
+	case BaronKillEvent:
+		t.baronNext = e.EventTime + baronRespawn
+		t.baronLast = t.teamOf[e.Killer]
+
+	case HeraldKillEvent:
+		t.heraldDone = true
+
+	case HordeKillEvent:
+		t.grubsKilled++
+		t.grubsLast = t.teamOf[e.Killer]
+		if t.grubsKilled >= t.grubsWave*grubsPerWave {
+			next := e.EventTime + grubsRespawn
+			if next > grubsLastWaveStart {
+				t.grubsDone = true
+			} else {
+				t.grubsNext = next
+				t.grubsWave++
+			}
+		}
+
+	case InhibKilledEvent:
+		t.inhibitors[e.Inhib] = e.EventTime + inhibitorRespawn
+		t.inhibitorTeam[e.Inhib] = t.teamOf[e.Killer]
+
+	case InhibRespawnedEvent:
+		delete(t.inhibitors, e.Inhib)
+		delete(t.inhibitorTeam, e.Inhib)
+	}
+}
+
+// State verilen oyun süresine göre hedeflerin durumunu hesaplar
+func (t *ObjectiveTracker) State(gameTime float64) ObjectiveState {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state := ObjectiveState{
+		DragonCount: make(map[string]int, len(t.dragonCount)),
+		DragonTypes: make(map[string][]string, len(t.dragonTypes)),
+		SoulTeam:    t.soulTeam,
+	}
+	for team, n := range t.dragonCount {
+		state.DragonCount[team] = n
+	}
+	for team, types := range t.dragonTypes {
+		state.DragonTypes[team] = append([]string(nil), types...)
+	}
+
+	dragon := ObjectiveDragon
+	if t.soulTeam != "" {
+		dragon = ObjectiveElder
+	}
+	state.Timers = append(state.Timers, ObjectiveTimer{
+		Objective:   dragon,
+		Key:         string(dragon),
+		NextSpawn:   t.dragonNext,
+		Alive:       gameTime >= t.dragonNext,
+		LastTakenBy: t.dragonLast,
+		Detail:      t.dragonType,
+	})
+
+	if !t.grubsDone && gameTime < heraldSpawn {
+		state.Timers = append(state.Timers, ObjectiveTimer{
+			Objective:   ObjectiveGrubs,
+			Key:         string(ObjectiveGrubs),
+			NextSpawn:   t.grubsNext,
+			Alive:       gameTime >= t.grubsNext,
+			LastTakenBy: t.grubsLast,
+		})
+	}
+
+	if !t.heraldDone && gameTime < heraldDespawn {
+		state.Timers = append(state.Timers, ObjectiveTimer{
+			Objective: ObjectiveHerald,
+			Key:       string(ObjectiveHerald),
+			NextSpawn: heraldSpawn,
+			Alive:     gameTime >= heraldSpawn,
+		})
+	}
+
+	state.Timers = append(state.Timers, ObjectiveTimer{
+		Objective:   ObjectiveBaron,
+		Key:         string(ObjectiveBaron),
+		NextSpawn:   t.baronNext,
+		Alive:       gameTime >= t.baronNext,
+		LastTakenBy: t.baronLast,
+	})
+
+	inhibs := make([]string, 0, len(t.inhibitors))
+	for name := range t.inhibitors {
+		inhibs = append(inhibs, name)
+	}
+	sort.Strings(inhibs)
+	for _, name := range inhibs {
+		respawn := t.inhibitors[name]
+		if gameTime >= respawn {
+			continue
+		}
+		state.Timers = append(state.Timers, ObjectiveTimer{
+			Objective:   ObjectiveInhibitor,
+			Key:         name,
+			NextSpawn:   respawn,
+			LastTakenBy: t.inhibitorTeam[name],
+			Detail:      describeInhibitor(name),
+		})
+	}
+
+	return state
+}
+
+// describeInhibitor "Barracks_T1_L1" -> "ORDER Top" gibi okunabilir isim üretir
+func describeInhibitor(name string) string {
+	parts := strings.Split(name, "_")
+	if len(parts) < 3 {
+		return name
+	}
+
+	owner := "ORDER"
+	if parts[1] == "T2" {
+		owner = "CHAOS"
+	}
+
+	lane := parts[2]
+	switch {
+	case strings.HasPrefix(lane, "L"):
+		lane = "Top"
+	case strings.HasPrefix(lane, "C"):
+		lane = "Mid"
+	case strings.HasPrefix(lane, "R"):
+		lane = "Bot"
+	}
+	return owner + " " + lane
+}
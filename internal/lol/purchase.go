@@ -0,0 +1,116 @@
+package lol
+
+import (
+	"sort"
+
+	"lol-helper/internal/staticdata"
+)
+
+// PurchaseStep şimdi satın alınabilecek bir item ve ödenecek altın
+type PurchaseStep struct {
+	ItemID int
+	Name   string
+	Cost   int // Eldeki bileşenler düşülmüş fiyat
+}
+
+// PurchasePlan önerilen bir item için şu anki altınla yapılabilecek alışveriş
+type PurchasePlan struct {
+	ItemID      int
+	Name        string
+	Owned       bool // Item zaten envanterde
+	Unavailable bool // Bu haritada veya bu şampiyonla alınamaz
+	Remaining   int  // Tamamlamak için gereken altın (eldeki bileşenler düşülmüş)
+	Discount    int  // Eldeki bileşenlerin toplam fiyattan düşürdüğü altın
+	Affordable  bool // Item şimdi tamamlanabilir
+	Buys        []PurchaseStep
+	GoldLeft    int // Buys alındıktan sonra kalan altın
+}
+
+// PlanPurchases önerilen itemlerin her biri için eldeki altın ve envanterle şimdi
+// neyin alınabileceğini hesaplar. Envanterdeki bileşenler birleştirme indirimi
+// olarak düşülür. Item tamamlanamıyorsa altının yettiği en pahalı bileşenler
+// seçilir; her öneri diğerlerinden bağımsız planlanır.
+func PlanPurchases(data *staticdata.GameData, targets []*staticdata.Item, inventory []int, gold, mapID int, champion string) []PurchasePlan {
+	owned := make(map[int]int, len(inventory))
+	for _, id := range inventory {
+		owned[id]++
+	}
+
+	plans := make([]PurchasePlan, 0, len(targets))
+	for _, it := range targets {
+		plan := PurchasePlan{ItemID: it.ID, Name: it.Name, GoldLeft: gold}
+		switch {
+		case owned[it.ID] > 0:
+			plan.Owned = true
+		case !it.AvailableOn(mapID) || !it.UsableBy(champion):
+			plan.Unavailable = true
+		default:
+			pool := copyCounts(owned)
+			plan.Remaining = remainingCost(data, it, pool)
+			plan.Discount = it.Gold.Total - plan.Remaining
+			if plan.Remaining <= gold {
+				plan.Affordable = true
+				plan.Buys = []PurchaseStep{{ItemID: it.ID, Name: it.Name, Cost: plan.Remaining}}
+				plan.GoldLeft = gold - plan.Remaining
+			} else {
+				plan.Buys, plan.GoldLeft = affordableComponents(data, it, copyCounts(owned), gold)
+			}
+		}
+		plans = append(plans, plan)
+	}
+	return plans
+}
+
+// remainingCost item'ı tamamlamak için ödenecek altını döner; kullanılan bileşenler pool'dan düşülür
+func remainingCost(data *staticdata.GameData, it *staticdata.Item, pool map[int]int) int {
+	if pool[it.ID] > 0 {
+		pool[it.ID]--
+		return 0
+	}
+	cost := it.Gold.Base
+	for _, c := range data.Components(it) {
+		cost += remainingCost(data, c, pool)
+	}
+	return cost
+}
+
+// affordableComponents item'ın yapım ağacında altının yettiği bileşenleri pahalıdan
+// ucuza seçer. Yetmeyen bileşenin yerine onun alt bileşenlerine bakılır.
+func affordableComponents(data *staticdata.GameData, it *staticdata.Item, pool map[int]int, gold int) ([]PurchaseStep, int) {
+	components := data.Components(it)
+	sort.SliceStable(components, func(i, j int) bool {
+		if components[i].Gold.Total != components[j].Gold.Total {
+			return components[i].Gold.Total > components[j].Gold.Total
+		}
+		return components[i].ID < components[j].ID
+	})
+
+	var steps []PurchaseStep
+	for _, c := range components {
+		if pool[c.ID] > 0 {
+			pool[c.ID]--
+			continue
+		}
+		trial := copyCounts(pool)
+		if cost := remainingCost(data, c, trial); cost <= gold {
+			steps = append(steps, PurchaseStep{ItemID: c.ID, Name: c.Name, Cost: cost})
+			gold -= cost
+			for id, n := range trial {
+				pool[id] = n
+			}
+			continue
+		}
+		var sub []PurchaseStep
+		sub, gold = affordableComponents(data, c, pool, gold)
+		steps = append(steps, sub...)
+	}
+	return steps, gold
+}
+
+func copyCounts(m map[int]int) map[int]int {
+	out := make(map[int]int, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
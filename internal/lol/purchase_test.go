@@ -0,0 +1,107 @@
+package lol
+
+import (
+	"reflect"
+	"testing"
+
+	"lol-helper/internal/staticdata"
+	"lol-helper/internal/staticdata/staticdatatest"
+)
+
+func TestPlanPurchases(t *testing.T) {
+	data := staticdatatest.Load(t)
+	item := func(id int) *staticdata.Item {
+		it, ok := data.Item(id)
+		if !ok {
+			t.Fatalf("fixture has no item %d", id)
+		}
+		return it
+	}
+
+	tests := []struct {
+		name      string
+		target    int
+		inventory []int
+		gold      int
+		mapID     int
+		champion  string
+		want      PurchasePlan
+	}{
+		{
+			name: "already owned", target: 3031, inventory: []int{3031}, gold: 500, mapID: 11,
+			want: PurchasePlan{ItemID: 3031, Name: "Infinity Edge", Owned: true, GoldLeft: 500},
+		},
+		{
+			name: "owned component discounts the item", target: 3031, inventory: []int{1038}, gold: 2100, mapID: 11,
+			want: PurchasePlan{ItemID: 3031, Name: "Infinity Edge", Remaining: 2100, Discount: 1300, Affordable: true,
+				Buys: []PurchaseStep{{ItemID: 3031, Name: "Infinity Edge", Cost: 2100}}},
+		},
+		{
+			name: "duplicated component counts each copy", target: 3124, inventory: []int{1043, 1043}, gold: 1000, mapID: 11,
+			want: PurchasePlan{ItemID: 3124, Name: "Guinsoo's Rageblade", Remaining: 1000, Discount: 2000, Affordable: true,
+				Buys: []PurchaseStep{{ItemID: 3124, Name: "Guinsoo's Rageblade", Cost: 1000}}},
+		},
+		{
+			name: "one owned copy of a duplicated component", target: 3124, inventory: []int{1043}, gold: 1500, mapID: 11,
+			want: PurchasePlan{ItemID: 3124, Name: "Guinsoo's Rageblade", Remaining: 2000, Discount: 1000,
+				Buys: []PurchaseStep{{ItemID: 1043, Name: "Recurve Bow", Cost: 1000}}, GoldLeft: 500},
+		},
+		{
+			name: "unaffordable component falls back to its sub-component", target: 3072, gold: 400, mapID: 11,
+			want: PurchasePlan{ItemID: 3072, Name: "Bloodthirster", Remaining: 3400,
+				Buys: []PurchaseStep{{ItemID: 1036, Name: "Long Sword", Cost: 350}}, GoldLeft: 50},
+		},
+		{
+			name: "owned sub-component discounts a partial step", target: 3072, inventory: []int{1036}, gold: 600, mapID: 11,
+			want: PurchasePlan{ItemID: 3072, Name: "Bloodthirster", Remaining: 3050, Discount: 350,
+				Buys: []PurchaseStep{{ItemID: 1053, Name: "Vampiric Scepter", Cost: 550}}, GoldLeft: 50},
+		},
+		{
+			name: "nothing affordable", target: 3031, gold: 100, mapID: 11,
+			want: PurchasePlan{ItemID: 3031, Name: "Infinity Edge", Remaining: 3400, GoldLeft: 100},
+		},
+		{
+			name: "not sold on the map", target: 3068, gold: 5000, mapID: 30,
+			want: PurchasePlan{ItemID: 3068, Name: "Sunfire Aegis", Unavailable: true, GoldLeft: 5000},
+		},
+		{
+			name: "sold on another map", target: 3068, gold: 5000, mapID: 11,
+			want: PurchasePlan{ItemID: 3068, Name: "Sunfire Aegis", Remaining: 2700, Affordable: true,
+				Buys: []PurchaseStep{{ItemID: 3068, Name: "Sunfire Aegis", Cost: 2700}}, GoldLeft: 2300},
+		},
+		{
+			name: "locked to another champion", target: 3599, gold: 0, mapID: 11, champion: "Ahri",
+			want: PurchasePlan{ItemID: 3599, Name: "The Black Spear", Unavailable: true},
+		},
+		{
+			name: "required champion", target: 3599, gold: 0, mapID: 11, champion: "Kalista",
+			want: PurchasePlan{ItemID: 3599, Name: "The Black Spear", Affordable: true,
+				Buys: []PurchaseStep{{ItemID: 3599, Name: "The Black Spear"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plans := PlanPurchases(data, []*staticdata.Item{item(tt.target)}, tt.inventory, tt.gold, tt.mapID, tt.champion)
+			if len(plans) != 1 {
+				t.Fatalf("got %d plans, want 1", len(plans))
+			}
+			if !reflect.DeepEqual(plans[0], tt.want) {
+				t.Errorf("plan = %+v\nwant   %+v", plans[0], tt.want)
+			}
+		})
+	}
+}
+
+func TestPlanPurchasesPlansTargetsIndependently(t *testing.T) {
+	data := staticdatatest.Load(t)
+	ie, _ := data.Item(3031)
+	bt, _ := data.Item(3072)
+
+	// Tek B. F. Sword iki önerinin ikisinde de indirim olarak sayılır
+	plans := PlanPurchases(data, []*staticdata.Item{ie, bt}, []int{1038}, 0, 11, "")
+	for _, p := range plans {
+		if p.Discount != 1300 {
+			t.Errorf("%s discount = %d, want 1300", p.Name, p.Discount)
+		}
+	}
+}
@@ -5,21 +5,60 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"lol-helper/internal/ai"
+	"lol-helper/internal/appdir"
+	"lol-helper/internal/i18n"
 	"lol-helper/internal/lcu"
+	"lol-helper/internal/staticdata"
 )
 
+// patchCheckInterval istemci sürümünün yeniden kontrol edildiği aralık (yama istemci açıkken de inebilir)
+const patchCheckInterval = time.Minute
+
 // Service LoL Helper ana servisi
 type Service struct {
 	lcuClient     *lcu.Client
 	liveClient    *lcu.LiveClient
 	aiService     *ai.Service
+	events        *EventTracker
+	objectives    *ObjectiveTracker
+	spells        *SpellTracker
+	gold          *GoldEstimator
+	spikes        *SpikeDetector
+	stats         *StatRecorder
+	skills        *SkillPlanner
+	benchmarks    *BenchmarkTracker
+	arena         *ArenaTracker
+	timeline      *TimelineRecorder
 	state         *HelperState
 	stopChan      chan struct{}
+	aiTrigger     chan struct{} // Önemli olaylarda AI analizini erkene çeker
 	onUpdate      func(*HelperState)
 	lastStateHash string // State değişiklik kontrolü için
+	gameID        string
+	perspective   string // İzleyici modunda AI koçunun baktığı oyuncu
+	perspectiveMu sync.Mutex
+	lastGameTime  float64
+	skillAsked    map[string]bool // AI'a yetenek sırası sorulmuş şampiyonlar
+	tierResolved  bool
+	augmentNames  []string // LCU'dan alınan Arena augment isimleri
+	augmentMu     sync.Mutex
+	augmentCtx    ai.AugmentRequest // Son güncellemedeki augment bağlamı (SuggestAugment için)
+	augmentCtxMu  sync.Mutex
+	tftHistory    []TFTPlacement // Yerel oyuncunun TFT geçmişi (oyun başına bir kez alınır)
+	tftLoaded     bool
+	static        *staticdata.Store // Data Dragon verisi; istemcinin yamasına göre yeniden yüklenir
+	gameVersion   string            // Data Dragon'un yüklendiği istemci sürümü ("14.3.561.1234")
+	patchMu       sync.Mutex
+	patchChecked  time.Time
 }
 
 // NewService yeni bir servis oluşturur
@@ -33,15 +72,196 @@ func NewService(onUpdate func(*HelperState)) (*Service, error) {
 	if err != nil {
 		return nil, fmt.Errorf("AI servisi başlatılamadı: %w", err)
 	}
+	aiService.SetLanguage(i18n.Current().Language())
+
+	// Veri klasörü yoksa istatistikler sadece bellekte tutulur
+	statsDir, err := appdir.Dir("stats")
+	if err != nil {
+		log.Printf("İstatistik klasörü kullanılamıyor: %v", err)
+	}
+	timelineDir, err := appdir.Dir("timelines")
+	if err != nil {
+		log.Printf("Zaman çizelgesi klasörü kullanılamıyor: %v", err)
+	}
+	skillsPath, benchmarksPath := "", ""
+	if dataDir, err := appdir.Dir(); err == nil {
+		skillsPath = filepath.Join(dataDir, "skill_orders.json")
+		benchmarksPath = filepath.Join(dataDir, "benchmarks.json")
+	}
+	benchmarks, err := LoadBenchmarks(benchmarksPath)
+	if err != nil {
+		log.Printf("Hedef tablosu yüklenemedi, varsayılanlar kullanılıyor: %v", err)
+		benchmarks = DefaultBenchmarks()
+	}
 
-	return &Service{
+	s := &Service{
 		lcuClient:  lcuClient,
 		liveClient: liveClient,
 		aiService:  aiService,
+		events:     NewEventTracker(liveClient),
+		objectives: NewObjectiveTracker(),
+		spells:     NewSpellTracker(),
+		gold:       NewGoldEstimator(),
+		spikes:     NewSpikeDetector(),
+		stats:      NewStatRecorder(statsDir),
+		skills:     NewSkillPlanner(skillsPath),
+		skillAsked: make(map[string]bool),
+		benchmarks: NewBenchmarkTracker(benchmarks),
+		arena:      NewArenaTracker(),
+		timeline:   NewTimelineRecorder(timelineDir),
 		state:      NewHelperState(),
 		stopChan:   make(chan struct{}),
+		aiTrigger:  make(chan struct{}, 1),
 		onUpdate:   onUpdate,
-	}, nil
+	}
+	s.benchmarks.SetTier(os.Getenv("LOL_HELPER_BENCHMARK_TIER"))
+	s.tierResolved = os.Getenv("LOL_HELPER_BENCHMARK_TIER") != ""
+	s.events.Subscribe(s.onEvent)
+	s.events.Subscribe(s.objectives.HandleEvent)
+	s.events.Subscribe(s.spells.HandleEvent)
+	s.events.Subscribe(s.stats.HandleEvent)
+	s.events.Subscribe(s.skills.HandleEvent)
+	s.events.Subscribe(s.timeline.HandleEvent)
+
+	return s, nil
+}
+
+// Events oyun olayı akışını döner (GUI ve diğer bileşenler abone olabilir)
+func (s *Service) Events() *EventTracker {
+	return s.events
+}
+
+// SetItemCatalog altın tahmini ve item bildirimleri için item kataloğunu ayarlar
+func (s *Service) SetItemCatalog(catalog ItemCatalog) {
+	s.gold.SetCatalog(catalog)
+	s.spikes.SetCatalog(catalog)
+}
+
+// SetStaticData Data Dragon deposunu ayarlar; item kataloğu olarak da kullanılır.
+// LCU bağlanınca ve istemcinin yaması değiştikçe depo o yamaya göre yenilenir.
+func (s *Service) SetStaticData(store *staticdata.Store) {
+	s.static = store
+	s.SetItemCatalog(store)
+}
+
+// Spells rakip sihirdar büyüsü takipçisini döner
+func (s *Service) Spells() *SpellTracker {
+	return s.spells
+}
+
+// Stats yerel oyuncunun kayıtlı istatistik geçmişlerini döner
+func (s *Service) Stats() *StatRecorder {
+	return s.stats
+}
+
+// SetSkillOrder şampiyon için kullanıcının yetenek sırasını kaydeder
+func (s *Service) SetSkillOrder(champion string, maxOrder []string) error {
+	return s.skills.SetOrder(champion, maxOrder, SkillSourceUser)
+}
+
+// SetPerspective izleyici modunda AI koçunun hangi oyuncunun gözünden bakacağını ayarlar.
+// Boş isim tarafsız görünüme döner (AI analizi yapılmaz).
+func (s *Service) SetPerspective(summonerName string) {
+	s.perspectiveMu.Lock()
+	s.perspective = summonerName
+	s.perspectiveMu.Unlock()
+
+	// Yeni bakış açısı için analizi hemen yenile
+	select {
+	case s.aiTrigger <- struct{}{}:
+	default:
+	}
+}
+
+// Perspective izleyici modunda seçili oyuncuyu döner
+func (s *Service) Perspective() string {
+	s.perspectiveMu.Lock()
+	defer s.perspectiveMu.Unlock()
+	return s.perspective
+}
+
+// ArenaAugments Arena augment isimlerini LCU'dan alır (ilk başarılı çağrıdan sonra önbellekten).
+// İsimler uygulamanın değil League istemcisinin dilindedir: oyuncu seçenekleri oyunda bu
+// adlarla gördüğü için önbellek LOL_HELPER_LOCALE'e göre ayrılmaz.
+func (s *Service) ArenaAugments() ([]string, error) {
+	s.augmentMu.Lock()
+	defer s.augmentMu.Unlock()
+	if s.augmentNames != nil {
+		return s.augmentNames, nil
+	}
+	if s.lcuClient == nil || !s.lcuClient.IsConnected() {
+		return nil, fmt.Errorf("augment listesi için League istemcisi gerekli")
+	}
+	augments, err := s.lcuClient.GetLolGameDataAssetsV1CherryAugmentsJson()
+	if err != nil {
+		return nil, fmt.Errorf("augment listesi alınamadı: %w", err)
+	}
+	names := make([]string, 0, len(augments))
+	for _, a := range augments {
+		if a.NameTRA != "" {
+			names = append(names, a.NameTRA)
+		}
+	}
+	sort.Strings(names)
+	s.augmentNames = names
+	return names, nil
+}
+
+// AddAugment yerel oyuncunun Arena'da seçtiği augmenti kaydeder
+func (s *Service) AddAugment(name string) {
+	s.arena.AddAugment(name)
+	select {
+	case s.aiTrigger <- struct{}{}:
+	default:
+	}
+}
+
+// SuggestAugment Arena'da sunulan augmentlerden hangisinin seçileceğini AI'a sorar.
+// GUI goroutine'inden çağrılır; s.state yerine son güncellemede alınan kopyayı kullanır.
+func (s *Service) SuggestAugment(options []string) (*ai.AugmentResponse, error) {
+	s.augmentCtxMu.Lock()
+	req := s.augmentCtx
+	s.augmentCtxMu.Unlock()
+	req.Options = options
+	return s.aiService.SuggestAugment(req)
+}
+
+// storeAugmentContext augment önerisinin ihtiyaç duyduğu alanları durumdan kopyalar
+func (s *Service) storeAugmentContext() {
+	ctx := ai.AugmentRequest{
+		Champion: s.state.Game.Champion,
+		Items:    append([]string(nil), s.state.Game.Items...),
+	}
+	if arena := s.state.Game.Arena; arena != nil {
+		ctx.Round = arena.Round
+		ctx.Augments = arena.Augments
+		ctx.Partner = arena.Partner(ctx.Champion)
+		ctx.Opponents = arena.OpponentLabels()
+	}
+	s.augmentCtxMu.Lock()
+	s.augmentCtx = ctx
+	s.augmentCtxMu.Unlock()
+}
+
+// Timeline oyun başına zaman çizelgesi kaydedicisini döner
+func (s *Service) Timeline() *TimelineRecorder {
+	return s.timeline
+}
+
+// BenchmarkTier CS/görüş hedeflerinde kullanılan kademeyi döner
+func (s *Service) BenchmarkTier() string {
+	return s.benchmarks.Tier()
+}
+
+// onEvent oyunun gidişatını değiştiren olaylarda AI analizini tetikler
+func (s *Service) onEvent(ev Event) {
+	switch ev.(type) {
+	case AceEvent, BaronKillEvent, DragonKillEvent, InhibKilledEvent:
+		select {
+		case s.aiTrigger <- struct{}{}:
+		default: // Zaten bekleyen bir tetikleme var
+		}
+	}
 }
 
 // Start polling işlemini başlatır
@@ -52,6 +272,8 @@ func (s *Service) Start() {
 // Stop polling işlemini durdurur
 func (s *Service) Stop() {
 	close(s.stopChan)
+	s.stats.Flush()
+	s.timeline.Close()
 	s.aiService.Close()
 }
 
@@ -71,12 +293,19 @@ func (s *Service) pollLoop() {
 			s.updateGameState()
 		case <-aiTicker.C:
 			s.runAIAnalysis()
+		case <-s.aiTrigger:
+			s.runAIAnalysis()
+			aiTicker.Reset(20 * time.Second)
 		}
 	}
 }
 
 // updateGameState oyun durumunu günceller
 func (s *Service) updateGameState() {
+	if s.static != nil {
+		s.state.Game.Patch = s.static.Version()
+	}
+
 	// 1. Önce Live Client (Oyun İçi API) kontrol et
 	// Bu API sadece oyun içindeyken çalışır ve en doğru veriyi verir.
 	liveData, liveErr := s.liveClient.GetAllGameData()
@@ -86,63 +315,150 @@ func (s *Service) updateGameState() {
 		s.state.Game.IsConnected = true
 		s.state.Game.Phase = "InProgress"
 		s.state.Game.AllPlayers = liveData.AllPlayers
+		s.state.Game.Spectator = liveData.ActivePlayer.IsSpectator()
+		s.state.Game.ActivePlayer = &liveData.ActivePlayer
+		if s.state.Game.Spectator {
+			s.state.Game.ActivePlayer = nil
+		}
+		s.state.Game.GameTime = int(liveData.GameData.GameTime)
+		s.state.Game.GameID = s.resolveGameID(liveData)
+		s.state.Game.Mode = DetectMode(liveData.GameData.GameMode, liveData.GameData.MapNumber, 0)
+		s.state.Game.Bench = ChampSelectBench{}
 		s.state.Error = nil
 
+		// TFT'de skor tablosu, hedefler ve AI koçu yok; sadece lobi ve geçmiş gösterilir
+		if s.state.Game.Mode == ModeTFT {
+			s.state.Game.Arena = nil
+			s.state.Game.Composition = nil
+			s.state.Game.Benchmarks = nil
+			s.state.Game.SuggestedItems = nil
+			s.state.Game.Purchases = nil
+			s.connectLCU()
+			s.checkPatch()
+			lobby := tftLobbyFromLive(liveData.AllPlayers, liveData.ActivePlayer.SummonerName)
+			s.state.Game.TFT = NewTFTState(lobby, s.tftMatchHistory())
+			s.notifyUpdate()
+			return
+		}
+		s.state.Game.TFT = nil
+
+		// Yeni olayları çek ve abonelere yayınla. Kayıt önce oyuna hazırlanır ki
+		// ilk turun olayları zaman çizelgesine düşsün.
+		s.timeline.Begin(s.state.Game.GameID)
+		teamOf := make(map[string]string, len(liveData.AllPlayers))
+		for _, p := range liveData.AllPlayers {
+			teamOf[p.SummonerName] = p.Team
+			teamOf[p.RiotID] = p.Team
+			teamOf[p.RiotIDGameName] = p.Team
+		}
+		s.objectives.SetTeams(teamOf)
+		if err := s.events.Poll(liveData.GameData.GameTime); err != nil {
+			log.Printf("Olaylar alınamadı: %v", err)
+		}
+		s.state.Game.Objectives = s.objectives.State(liveData.GameData.GameTime)
+		s.state.Game.GoldEstimate = s.gold.Update(liveData.AllPlayers, liveData.GameData.GameTime)
+		s.state.Game.Notifications = s.spikes.Update(liveData.AllPlayers, liveData.GameData.GameTime)
+		// ARAM'da koridor olmadığı için rol hedefleri anlamsız
+		s.state.Game.Benchmarks = nil
+		if s.state.Game.Mode.HasLanes() {
+			s.resolveTier()
+			s.state.Game.Benchmarks = s.benchmarks.Update(liveData.AllPlayers, liveData.GameData.GameTime)
+		}
+
+		// İzleyici modunda yerel oyuncu yok; AI koçu kullanıcının seçtiği oyuncunun gözünden bakar
+		localName := liveData.ActivePlayer.SummonerName
+		if s.state.Game.Spectator {
+			localName = s.Perspective()
+		}
+		s.state.Game.Perspective = localName
+		s.state.Game.Gold = 0
+		s.state.Game.LocalTeam = ""
+		s.state.Game.Champion = ""
+		s.state.Game.Items = nil
+		s.state.Game.ItemIDs = nil
+		s.state.Game.EnemyChamps = nil
+
 		// Aktif oyuncu verilerini güncelle
 		for _, p := range liveData.AllPlayers {
-			if p.SummonerName == liveData.ActivePlayer.SummonerName {
-				s.state.Game.Gold = int(liveData.ActivePlayer.CurrentGold)
+			if localName != "" && p.SummonerName == localName {
+				s.state.Game.LocalTeam = p.Team
 				s.state.Game.Champion = p.ChampionName // Şampiyon ismini buradan al
 
 				// İtemleri güncelle
 				var items []string
+				var itemIDs []int
 				for _, item := range p.Items {
 					items = append(items, item.DisplayName)
+					itemIDs = append(itemIDs, item.ItemID)
 				}
 				s.state.Game.Items = items
+				s.state.Game.ItemIDs = itemIDs
+
+				for _, other := range liveData.AllPlayers {
+					if other.Team != p.Team {
+						s.state.Game.EnemyChamps = append(s.state.Game.EnemyChamps, other.ChampionName)
+					}
+				}
+
+				// Altın, istatistik ve yetenekler sadece kendi oyunumuzda görünür
+				if s.state.Game.Spectator {
+					break
+				}
+				s.state.Game.Gold = int(liveData.ActivePlayer.CurrentGold)
+				s.stats.Record(s.state.Game.GameID, p.ChampionName, liveData.GameData.GameTime, &liveData.ActivePlayer, items)
+				s.state.Game.Stats = s.stats.Current()
+				s.state.Game.Skills = s.skills.Update(p.ChampionName, liveData.ActivePlayer.Abilities, liveData.ActivePlayer.Level)
+				if s.state.Game.Skills.Order == nil {
+					s.requestSkillOrder(p.ChampionName, liveData.AllPlayers, p.Team)
+				}
 				break
 			}
 		}
+		if s.state.Game.Spectator {
+			s.state.Game.Stats = nil
+			s.state.Game.Skills = SkillState{}
+		}
 
-		// LCU bağlantısını arka planda dene ama başarısız olsa bile akışı bozma
-		if s.lcuClient == nil || !s.lcuClient.IsConnected() {
-			if s.lcuClient == nil {
-				client, err := lcu.NewClient()
-				if err == nil {
-					s.lcuClient = client
+		// Arena'da iki takım yerine dört ikili var; rakipler yerel ikili dışındaki herkes
+		s.state.Game.Arena = nil
+		s.state.Game.Composition = nil
+		if s.state.Game.Mode == ModeArena {
+			arena := s.arena.Update(liveData.AllPlayers, localName, liveData.GameData.GameTime)
+			s.state.Game.Arena = &arena
+			if arena.LocalDuo != 0 {
+				s.state.Game.EnemyChamps = nil
+				for _, duo := range arena.Opponents() {
+					s.state.Game.EnemyChamps = append(s.state.Game.EnemyChamps, duo.Champions...)
 				}
-			} else {
-				s.lcuClient.TryConnect()
 			}
+		} else if data := s.gameData(); data != nil {
+			s.state.Game.Composition = LiveCompositions(data, liveData.AllPlayers, s.state.Game.LocalTeam)
 		}
+		s.storeAugmentContext()
+
+		// LCU bağlantısını arka planda dene ama başarısız olsa bile akışı bozma
+		s.connectLCU()
+		s.checkPatch()
+		s.updatePurchases()
 
+		s.timeline.Snapshot(s.state.Game.GameID, s.state.Game)
 		s.notifyUpdate()
 		return
 	}
 
 	// 2. Eğer Live Client yanıt vermiyorsa, LCU (Client API) kontrol et
-	if s.lcuClient == nil || !s.lcuClient.IsConnected() {
-		if s.lcuClient == nil {
-			client, err := lcu.NewClient()
-			if err == nil {
-				s.lcuClient = client
-			}
-		} else {
-			s.lcuClient.TryConnect()
-		}
-
-		if s.lcuClient == nil || !s.lcuClient.IsConnected() {
-			// İkisi de yoksa bağlantı yok demektir
-			s.state.Game.Phase = "Disconnected"
-			s.state.Game.IsConnected = false
-			// Disconnected durumunda player listesini temizlemiyoruz
-			// Böylece anlık kopmalarda liste kaybolmaz
-			s.notifyUpdate()
-			return
-		}
+	if !s.connectLCU() {
+		// İkisi de yoksa bağlantı yok demektir
+		s.state.Game.Phase = "Disconnected"
+		s.state.Game.IsConnected = false
+		// Disconnected durumunda player listesini temizlemiyoruz
+		// Böylece anlık kopmalarda liste kaybolmaz
+		s.notifyUpdate()
+		return
 	}
 
 	// LCU Bağlı, verileri çek
+	s.checkPatch()
 	gameData, err := s.lcuClient.GetActiveGame()
 	if err != nil {
 		// Oyun yok (Lobby veya başka bir durum)
@@ -152,6 +468,12 @@ func (s *Service) updateGameState() {
 
 		// Lobby'deysek player listesini temizle
 		s.state.Game.AllPlayers = nil
+		s.state.Game.Composition = nil
+		s.state.Game.Bench = ChampSelectBench{}
+		s.state.Game.TFT = nil
+		// Oyun bitti; sonraki TFT oyununda geçmiş yeni sonuçla tekrar alınır
+		s.tftHistory = nil
+		s.tftLoaded = false
 
 		s.notifyUpdate()
 		return
@@ -163,17 +485,207 @@ func (s *Service) updateGameState() {
 		log.Printf("Summoner bilgisi alınamadı: %v", err)
 	}
 
-	s.state.UpdateFromLCU(gameData, summoner)
+	s.state.UpdateFromLCU(gameData, summoner, s.gameData())
+	s.resolveSuggestedItems()
+	s.state.Game.Purchases = nil
+	s.state.Game.TFT = nil
+	if s.state.Game.Mode == ModeTFT {
+		s.state.Game.TFT = NewTFTState(s.tftSessionLobby(summoner), s.tftMatchHistory())
+	}
 	s.state.Error = nil
 	s.notifyUpdate()
 }
 
+// connectLCU LCU istemcisi yoksa oluşturur, bağlı değilse yeniden bağlanmayı dener
+func (s *Service) connectLCU() bool {
+	if s.lcuClient == nil {
+		client, err := lcu.NewClient()
+		if err != nil {
+			return false
+		}
+		s.lcuClient = client
+	} else if !s.lcuClient.IsConnected() {
+		s.lcuClient.TryConnect()
+	}
+	return s.lcuClient.IsConnected()
+}
+
+// tftMatchHistory yerel oyuncunun TFT geçmişini LCU'dan oyun başına bir kez alır
+func (s *Service) tftMatchHistory() []TFTPlacement {
+	if s.tftLoaded || s.lcuClient == nil || !s.lcuClient.IsConnected() {
+		return s.tftHistory
+	}
+	summoner, err := s.lcuClient.GetCurrentSummoner()
+	if err != nil {
+		return s.tftHistory
+	}
+	// Hata alınsa da tekrar denenmez, her polling'de istek atılmasın
+	s.tftLoaded = true
+	history, err := s.lcuClient.GetLolMatchHistoryV1ProductsTftByPuuidMatches(summoner.Puuid, 0, tftHistoryCount)
+	if err != nil {
+		log.Printf("TFT maç geçmişi alınamadı: %v", err)
+		return nil
+	}
+	s.tftHistory = TFTHistoryFromMatches(history, summoner.Puuid)
+	return s.tftHistory
+}
+
+// tftSessionLobby Live Client hazır olmadan önce TFT lobisini oyun akışı oturumundan alır
+func (s *Service) tftSessionLobby(summoner *lcu.Summoner) []TFTPlayer {
+	session, err := s.lcuClient.GetLolGameflowV1Session()
+	if err != nil {
+		return nil
+	}
+	puuid := ""
+	if summoner != nil {
+		puuid = summoner.Puuid
+	}
+	return tftLobbyFromSession(session, puuid)
+}
+
+// requestSkillOrder kayıtlı sırası olmayan şampiyon için AI'dan arka planda sıra ister.
+// Her şampiyon için uygulama açıkken bir kez sorulur.
+func (s *Service) requestSkillOrder(champion string, players []lcu.LivePlayer, team string) {
+	if champion == "" || s.skillAsked[champion] {
+		return
+	}
+	s.skillAsked[champion] = true
+
+	var enemies []string
+	for _, p := range players {
+		if p.Team != team {
+			enemies = append(enemies, p.ChampionName)
+		}
+	}
+
+	go func() {
+		order, err := s.aiService.SuggestSkillOrder(champion, enemies)
+		if err == nil {
+			order, err = ParseSkillOrder(strings.Join(order, ""))
+		}
+		if err != nil {
+			log.Printf("Yetenek sırası önerisi alınamadı: %v", err)
+			return
+		}
+		if err := s.skills.SetOrder(champion, order, SkillSourceAI); err != nil {
+			log.Printf("%v", err)
+		}
+	}()
+}
+
+// resolveTier hedef karşılaştırması için yerel oyuncunun tekli dereceli kademesini
+// LCU'dan bir kez alır. LOL_HELPER_BENCHMARK_TIER ayarlıysa o kullanılır.
+func (s *Service) resolveTier() {
+	if s.tierResolved || s.lcuClient == nil || !s.lcuClient.IsConnected() {
+		return
+	}
+	stats, err := s.lcuClient.GetLolRankedV1CurrentRankedStats()
+	if err != nil {
+		return
+	}
+	s.tierResolved = true
+	if solo, ok := stats.QueueMap["RANKED_SOLO_5x5"]; ok && solo.Tier != "" && solo.Tier != "NONE" {
+		s.benchmarks.SetTier(solo.Tier)
+	}
+}
+
+// checkPatch istemcinin oyun sürümünü LCU'dan okur ve sürüm değiştiyse Data Dragon
+// deposunu o yamaya göre yeniden yükler (indirme sürebileceği için arka planda).
+// Böylece PBE veya gecikmeli bölge güncellemelerinde de item ID'leri Live Client'ın
+// bildirdikleriyle eşleşir. Live Client oyun sürümünü vermediği için LCU'suz
+// oyunlarda yüklü (veya en yeni) yama kullanılmaya devam eder.
+func (s *Service) checkPatch() {
+	if s.static == nil || s.lcuClient == nil || !s.lcuClient.IsConnected() || time.Since(s.patchChecked) < patchCheckInterval {
+		return
+	}
+	s.patchChecked = time.Now()
+
+	version, err := s.lcuClient.GetLolPatchV1GameVersion()
+	if err != nil || version == "" {
+		// lol-patch yanıt vermezse istemci derleme sürümü kullanılır
+		if version, err = s.lcuClient.GetBuildVersion(); err != nil || version == "" {
+			return
+		}
+	}
+
+	s.patchMu.Lock()
+	defer s.patchMu.Unlock()
+	if version == s.gameVersion {
+		return
+	}
+	s.gameVersion = version
+	go func() {
+		g, err := s.static.Refresh(version)
+		if err != nil {
+			log.Printf("Data Dragon verisi yüklenemedi (istemci %s): %v", version, err)
+			// Bir sonraki kontrolde tekrar denensin
+			s.patchMu.Lock()
+			s.gameVersion = ""
+			s.patchMu.Unlock()
+			return
+		}
+		log.Printf("Data Dragon yaması %s yüklendi (istemci %s)", g.Version, version)
+	}()
+}
+
+// resolveGameID oyunun kimliğini bulur. LCU oturumundaki gameId tercih edilir;
+// LCU yoksa oyunculardan yerel bir kimlik üretilir. Kimlik oyun boyunca
+// saklanır, oyun süresi geri giderse (yeni oyun) yeniden belirlenir.
+func (s *Service) resolveGameID(live *lcu.LiveGameData) string {
+	gameTime := live.GameData.GameTime
+	if gameTime < s.lastGameTime {
+		s.gameID = ""
+	}
+	s.lastGameTime = gameTime
+
+	// Yerel kimlik LCU bağlanınca gerçek kimlikle değiştirilmez, kayıtlar bölünmesin
+	if s.gameID != "" {
+		return s.gameID
+	}
+
+	if s.lcuClient != nil && s.lcuClient.IsConnected() {
+		session, err := s.lcuClient.GetLolGameflowV1Session()
+		if err == nil && session.GameData.GameID != 0 {
+			s.gameID = strconv.FormatUint(session.GameData.GameID, 10)
+			return s.gameID
+		}
+	}
+
+	s.gameID = localGameID(live)
+	return s.gameID
+}
+
+// localGameID LCU olmadan oyun boyunca değişmeyen bir kimlik üretir: oyuncular,
+// şampiyonları ve mod aynı kaldıkça uygulama oyun ortasında yeniden başlatılsa da
+// aynı kimlik bulunur ve istatistik ile zaman çizelgesi bölünmez.
+func localGameID(live *lcu.LiveGameData) string {
+	players := make([]string, 0, len(live.AllPlayers))
+	for _, p := range live.AllPlayers {
+		name := p.RiotID
+		if name == "" {
+			name = p.SummonerName
+		}
+		players = append(players, name+"/"+p.ChampionName)
+	}
+	sort.Strings(players)
+	key := fmt.Sprintf("%s|%d|%s", live.GameData.GameMode, live.GameData.MapNumber, strings.Join(players, ","))
+	return fmt.Sprintf("local-%x", md5.Sum([]byte(key)))[:len("local-")+16]
+}
+
 // runAIAnalysis AI analizi yapar
 func (s *Service) runAIAnalysis() {
 	// Sadece oyun içindeyse veya şampiyon seçimindeyse analiz yap
 	if s.state.Game.Phase != "InProgress" && s.state.Game.Phase != "ChampSelect" {
 		return
 	}
+	// İzleyici modunda bakış açısı seçilmeden koçluk yapılmaz
+	if s.state.Game.Spectator && s.state.Game.Champion == "" {
+		return
+	}
+	// TFT için koçluk istemi yok
+	if s.state.Game.Mode == ModeTFT {
+		return
+	}
 
 	req := ai.AnalysisRequest{
 		GamePhase:   s.state.Game.Phase,
@@ -182,6 +694,28 @@ func (s *Service) runAIAnalysis() {
 		Gold:        s.state.Game.Gold,
 		EnemyChamps: s.state.Game.EnemyChamps,
 		GameTime:    s.state.Game.GameTime,
+		Spectator:   s.state.Game.Spectator,
+		Composition: s.state.Game.Composition.PromptSummary(),
+		Mode:        string(s.state.Game.Mode),
+	}
+	if arena := s.state.Game.Arena; arena != nil {
+		req.ArenaRound = arena.Round
+		req.Augments = arena.Augments
+		req.ArenaPartner = arena.Partner(s.state.Game.Champion)
+		req.ArenaOpponents = arena.OpponentLabels()
+	}
+	if s.state.Game.Mode == ModeARAM {
+		req.Bench = s.state.Game.Bench.Champions
+		req.RerollsRemaining = s.state.Game.Bench.RerollsRemaining
+		champions := append([]string(nil), req.Bench...)
+		if s.state.Game.Composition != nil {
+			for _, team := range s.state.Game.Composition.Teams {
+				champions = append(champions, team.Champions...)
+			}
+		}
+		if data := s.gameData(); data != nil {
+			req.ARAMBalance = ARAMBalanceSummary(data, champions)
+		}
 	}
 
 	resp, err := s.aiService.AnalyzeGame(req)
@@ -197,9 +731,67 @@ func (s *Service) runAIAnalysis() {
 		NextItems:  resp.NextItems,
 		Strategy:   resp.Strategy,
 	}
+	if s.state.Game.Phase == "InProgress" {
+		s.timeline.Recommendation(float64(s.state.Game.GameTime), *s.state.Recommendation)
+	}
+	s.updatePurchases()
 	s.notifyUpdate()
 }
 
+// gameData yüklü yamanın Data Dragon verisi; store yoksa veya yama henüz yüklenmediyse nil
+func (s *Service) gameData() *staticdata.GameData {
+	if s.static == nil {
+		return nil
+	}
+	return s.static.Current()
+}
+
+// resolveSuggestedItems AI'ın yazdığı item adlarını yüklü yamanın itemlerine
+// çevirir. Çözülemeyen adlar ItemID 0 ile kalır ki yanlış ikonla değil adıyla gösterilsin.
+func (s *Service) resolveSuggestedItems() {
+	s.state.Game.SuggestedItems = nil
+	if s.state.Recommendation == nil || len(s.state.Recommendation.NextItems) == 0 {
+		return
+	}
+	data := s.gameData()
+	mapID := s.state.Game.Mode.MapID()
+	for _, name := range s.state.Recommendation.NextItems {
+		suggestion := ItemSuggestion{Name: name}
+		if data != nil {
+			if match, ok := data.ResolveItem(name, mapID); ok {
+				suggestion.ItemID = match.Item.ID
+				suggestion.ItemName = match.Item.Name
+				suggestion.Confidence = match.Confidence
+			} else {
+				suggestion.Unresolved = true
+			}
+		}
+		s.state.Game.SuggestedItems = append(s.state.Game.SuggestedItems, suggestion)
+	}
+}
+
+// updatePurchases AI'ın önerdiği itemleri çözer ve şimdi alınabilecekleri hesaplar.
+// Altın sadece kendi oyunumuzda görünür; izlerken ve oyun dışında plan yapılmaz.
+func (s *Service) updatePurchases() {
+	s.resolveSuggestedItems()
+	s.state.Game.Purchases = nil
+	if s.static == nil || s.state.Game.Phase != "InProgress" || s.state.Game.Spectator || s.state.Recommendation == nil {
+		return
+	}
+	data := s.static.Current()
+	if data == nil {
+		return
+	}
+	var targets []*staticdata.Item
+	for _, suggestion := range s.state.Game.SuggestedItems {
+		if it, ok := data.Item(suggestion.ItemID); ok {
+			targets = append(targets, it)
+		}
+	}
+	s.state.Game.Purchases = PlanPurchases(data, targets, s.state.Game.ItemIDs, s.state.Game.Gold,
+		s.state.Game.Mode.MapID(), s.state.Game.Champion)
+}
+
 // notifyUpdate UI'ı günceller - sadece state değiştiyse
 func (s *Service) notifyUpdate() {
 	// State'in hash'ini hesapla
@@ -233,6 +825,19 @@ func (s *Service) calculateStateHash() string {
 		Gold        int
 		Champion    string
 		ItemCount   int
+		GameTime    int // Hedef sayaçlarının ilerlemesi için
+		Spectator   bool
+		Perspective string
+		SkillNext   string
+		SkillNote   string
+		Composition string
+		Mode        GameMode
+		Patch       string
+		Bench       string
+		Arena       string
+		TFT         string
+		Suggested   string
+		Purchases   string
 	}{
 		Phase:       s.state.Game.Phase,
 		IsConnected: s.state.Game.IsConnected,
@@ -240,9 +845,46 @@ func (s *Service) calculateStateHash() string {
 		Gold:        s.state.Game.Gold,
 		Champion:    s.state.Game.Champion,
 		ItemCount:   len(s.state.Game.Items),
+		GameTime:    s.state.Game.GameTime,
+		Spectator:   s.state.Game.Spectator,
+		Perspective: s.state.Game.Perspective,
+		SkillNext:   s.state.Game.Skills.Next,
+		SkillNote:   s.state.Game.Skills.Note,
+		Composition: s.state.Game.Composition.PromptSummary(),
+		Mode:        s.state.Game.Mode,
+		Patch:       s.state.Game.Patch,
+		Bench:       fmt.Sprintf("%v/%d", s.state.Game.Bench.Champions, s.state.Game.Bench.RerollsRemaining),
+		Arena:       arenaHashKey(s.state.Game.Arena),
+		TFT:         tftHashKey(s.state.Game.TFT),
+		Suggested:   fmt.Sprintf("%v", s.state.Game.SuggestedItems),
+		Purchases:   fmt.Sprintf("%v", s.state.Game.Purchases),
 	}
 
 	jsonData, _ := json.Marshal(data)
 	hash := md5.Sum(jsonData)
 	return fmt.Sprintf("%x", hash)
 }
+
+// arenaHashKey Arena durumunun arayüzü etkileyen kısmını (tur, elenenler, augmentler) döner
+func arenaHashKey(arena *ArenaState) string {
+	if arena == nil {
+		return ""
+	}
+	key := fmt.Sprintf("%d|%d|%v|", arena.Round, arena.LocalDuo, arena.Augments)
+	for _, duo := range arena.Duos {
+		key += fmt.Sprintf("%d:%t,", duo.ID, duo.Eliminated)
+	}
+	return key
+}
+
+// tftHashKey TFT durumunun arayüzü etkileyen kısmını (lobi, geçmiş) döner
+func tftHashKey(tft *TFTState) string {
+	if tft == nil {
+		return ""
+	}
+	key := fmt.Sprintf("%d|", len(tft.History))
+	for _, p := range tft.Lobby {
+		key += fmt.Sprintf("%s:%t,", p.Name, p.Local)
+	}
+	return key
+}
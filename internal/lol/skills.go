@@ -0,0 +1,289 @@
+package lol
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"lol-helper/internal/appdir"
+	"lol-helper/internal/i18n"
+	"lol-helper/internal/lcu"
+)
+
+// Yetenek sırası kaynakları
+const (
+	SkillSourceUser = "user"
+	SkillSourceAI   = "ai"
+)
+
+// basicSkills R dışındaki yetenekler
+var basicSkills = []string{"Q", "W", "E"}
+
+// ultimateLevels ultinin yükseltilebildiği seviyeler
+var ultimateLevels = []int{6, 11, 16}
+
+// maxChampionLevel şampiyonun ulaşabileceği en yüksek seviye
+const maxChampionLevel = 18
+
+// SkillOrder bir şampiyon için önerilen yetenek maksimize sırası (örn. Q > E > W)
+type SkillOrder struct {
+	MaxOrder []string `json:"maxOrder"`
+	Source   string   `json:"source"` // user veya ai
+}
+
+// String sırayı "Q > E > W" biçiminde döner
+func (o SkillOrder) String() string {
+	return strings.Join(o.MaxOrder, " > ")
+}
+
+// ParseSkillOrder "Q>E>W", "QEW" veya "q e w" gibi bir girdiyi maksimize sırasına çevirir
+func ParseSkillOrder(s string) ([]string, error) {
+	var order []string
+	seen := make(map[string]bool)
+	for _, r := range strings.ToUpper(s) {
+		key := string(r)
+		if key != "Q" && key != "W" && key != "E" {
+			continue
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("%s yeteneği birden fazla yazılmış", key)
+		}
+		seen[key] = true
+		order = append(order, key)
+	}
+	if len(order) != len(basicSkills) {
+		return nil, errors.New("sıra Q, W ve E yeteneklerinin hepsini içermeli")
+	}
+	return order, nil
+}
+
+// ultimateRanks verilen seviyede ultinin alabileceği en yüksek seviye
+func ultimateRanks(level int) int {
+	ranks := 0
+	for _, l := range ultimateLevels {
+		if level >= l {
+			ranks++
+		}
+	}
+	return ranks
+}
+
+// nextSkill mevcut yetenek seviyelerine göre sıradaki yükseltilecek yeteneği seçer.
+// R açıldıkça önceliklidir; ilk üç puan her temel yeteneğe birer kez, sonrası
+// maksimize sırasına göre verilir (temel yetenek seviyesi en fazla (seviye+1)/2).
+func nextSkill(maxOrder []string, levels map[string]int, championLevel int) string {
+	if levels["R"] < ultimateRanks(championLevel) {
+		return "R"
+	}
+
+	learned := 0
+	for _, s := range basicSkills {
+		if levels[s] > 0 {
+			learned++
+		}
+	}
+	if learned < len(basicSkills) {
+		for _, s := range maxOrder {
+			if levels[s] == 0 {
+				return s
+			}
+		}
+	}
+
+	limit := (championLevel + 1) / 2
+	for _, s := range maxOrder {
+		if levels[s] < 5 && levels[s] < limit {
+			return s
+		}
+	}
+	return ""
+}
+
+// PlanSkillSequence maksimize sırasından 1-18. seviyeler için yükseltme listesi üretir
+func PlanSkillSequence(maxOrder []string) []string {
+	levels := make(map[string]int)
+	sequence := make([]string, 0, maxChampionLevel)
+	for level := 1; level <= maxChampionLevel; level++ {
+		skill := nextSkill(maxOrder, levels, level)
+		if skill == "" {
+			break
+		}
+		levels[skill]++
+		sequence = append(sequence, skill)
+	}
+	return sequence
+}
+
+// SkillDeviation planlanandan farklı yükseltilen bir seviye
+type SkillDeviation struct {
+	Level   int
+	Planned string
+	Actual  string
+}
+
+// SkillState yerel oyuncunun yetenek yükseltme durumu
+type SkillState struct {
+	Champion   string
+	Order      *SkillOrder // Şampiyon için kayıtlı sıra yoksa nil
+	Levels     map[string]int
+	Next       string // Sıradaki yükseltilecek yetenek
+	Unspent    int    // Harcanmamış yetenek puanı
+	Actual     []string
+	Deviations []SkillDeviation
+	Note       string // Oyun sonu notu (oyun bitince dolar)
+}
+
+// SkillPlanner şampiyon başına yetenek sıralarını (kullanıcı ayarı veya AI önerisi)
+// saklar, oyuncunun yükselttiği yetenekleri takip eder ve plandan sapmaları bulur
+type SkillPlanner struct {
+	mu      sync.Mutex
+	path    string
+	presets map[string]SkillOrder
+
+	champion string
+	levels   map[string]int
+	actual   []string
+	offset   int // Takip başladığında zaten harcanmış puan (oyunun ortasında açıldıysa)
+	tracking bool
+	note     string
+}
+
+// NewSkillPlanner path'teki JSON dosyasından sıraları yükler. Dosya yoksa boş başlar.
+func NewSkillPlanner(path string) *SkillPlanner {
+	p := &SkillPlanner{path: path, presets: make(map[string]SkillOrder)}
+	if path != "" {
+		if data, err := os.ReadFile(path); err == nil {
+			_ = json.Unmarshal(data, &p.presets)
+		}
+	}
+	return p
+}
+
+// Order şampiyon için kayıtlı sırayı döner
+func (p *SkillPlanner) Order(champion string) (SkillOrder, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	o, ok := p.presets[champion]
+	return o, ok
+}
+
+// SetOrder şampiyon için sırayı kaydeder. Kullanıcı sırası AI önerisinin üzerine yazılmaz.
+func (p *SkillPlanner) SetOrder(champion string, maxOrder []string, source string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if existing, ok := p.presets[champion]; ok && existing.Source == SkillSourceUser && source != SkillSourceUser {
+		return nil
+	}
+	p.presets[champion] = SkillOrder{MaxOrder: maxOrder, Source: source}
+
+	if p.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(p.presets, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := appdir.WriteFile(p.path, data); err != nil {
+		return fmt.Errorf("yetenek sıraları kaydedilemedi: %w", err)
+	}
+	return nil
+}
+
+// Update yetenek seviyelerini bir önceki polling ile karşılaştırır ve güncel durumu döner
+func (p *SkillPlanner) Update(champion string, abilities lcu.LiveAbilities, level int) SkillState {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	current := map[string]int{
+		"Q": abilities.Q.AbilityLevel,
+		"W": abilities.W.AbilityLevel,
+		"E": abilities.E.AbilityLevel,
+		"R": abilities.R.AbilityLevel,
+	}
+	spent := 0
+	for _, v := range current {
+		spent += v
+	}
+
+	// Yeni oyun veya ilk görüş: mevcut seviyeleri temel al
+	if !p.tracking || p.champion != champion || spent < len(p.actual)+p.offset {
+		p.champion = champion
+		p.actual = nil
+		p.offset = spent
+		p.note = ""
+		p.tracking = true
+	} else {
+		// İki polling arasında birden fazla puan harcandıysa Q, W, E, R sırasıyla eklenir
+		for _, s := range []string{"Q", "W", "E", "R"} {
+			for i := p.levels[s]; i < current[s]; i++ {
+				p.actual = append(p.actual, s)
+			}
+		}
+	}
+	p.levels = current
+
+	st := SkillState{
+		Champion: champion,
+		Levels:   current,
+		Actual:   append([]string(nil), p.actual...),
+		Note:     p.note,
+	}
+	if level > spent {
+		st.Unspent = level - spent
+	}
+	if o, ok := p.presets[champion]; ok {
+		order := o
+		st.Order = &order
+		st.Next = nextSkill(o.MaxOrder, current, level)
+		st.Deviations = p.deviations(o.MaxOrder)
+	}
+	return st
+}
+
+// deviations takip edilen yükseltmeleri planla seviye seviye karşılaştırır
+func (p *SkillPlanner) deviations(maxOrder []string) []SkillDeviation {
+	plan := PlanSkillSequence(maxOrder)
+	var out []SkillDeviation
+	for i, actual := range p.actual {
+		idx := p.offset + i
+		if idx >= len(plan) {
+			break
+		}
+		if plan[idx] != actual {
+			out = append(out, SkillDeviation{Level: idx + 1, Planned: plan[idx], Actual: actual})
+		}
+	}
+	return out
+}
+
+// HandleEvent oyun bitince yetenek sırası notunu hazırlar (EventTracker aboneliği)
+func (p *SkillPlanner) HandleEvent(ev Event) {
+	if _, ok := ev.(GameEndEvent); !ok {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	o, ok := p.presets[p.champion]
+	if !ok || !p.tracking {
+		return
+	}
+	p.note = describeSkillDeviations(o, p.deviations(o.MaxOrder))
+}
+
+// describeSkillDeviations oyun sonu notunu oluşturur
+func describeSkillDeviations(o SkillOrder, deviations []SkillDeviation) string {
+	if len(deviations) == 0 {
+		return i18n.T("skills.followed", o)
+	}
+	parts := make([]string, 0, len(deviations))
+	for _, d := range deviations {
+		parts = append(parts, i18n.T("skills.deviation", d.Level, d.Actual, d.Planned))
+	}
+	return i18n.T("skills.summary", o, len(deviations), strings.Join(parts, ", "))
+}
@@ -0,0 +1,198 @@
+package lol
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"lol-helper/internal/lcu"
+)
+
+// Sihirdar büyüsü hızlandırması kaynakları
+const (
+	ionianBootsItemID   = 3158 // İyonya Çizmeleri: +10 sihirdar büyüsü hızlandırması
+	ionianBootsHaste    = 10
+	cosmicInsightRuneID = 8347 // Kozmik İçgörü: +18 sihirdar büyüsü hızlandırması
+	cosmicInsightHaste  = 18
+)
+
+// summonerSpellCooldowns sihirdar büyülerinin temel bekleme süreleri (saniye)
+var summonerSpellCooldowns = map[string]float64{
+	"SummonerFlash":    300,
+	"SummonerDot":      180, // Tutuştur
+	"SummonerTeleport": 360,
+	"SummonerHeal":     240,
+	"SummonerBarrier":  180,
+	"SummonerExhaust":  240,
+	"SummonerBoost":    210, // Arındır
+	"SummonerHaste":    240, // Hayalet
+	"SummonerSmite":    90,
+	"SummonerMana":     240, // Berraklık
+	"SummonerSnowball": 80,  // İşaretle (ARAM)
+}
+
+// summonerSpellCallouts sohbet mesajlarında kullanılan kısa büyü isimleri
+var summonerSpellCallouts = map[string]string{
+	"SummonerFlash":    "flash",
+	"SummonerDot":      "ignite",
+	"SummonerTeleport": "tp",
+	"SummonerHeal":     "heal",
+	"SummonerBarrier":  "barrier",
+	"SummonerExhaust":  "exhaust",
+	"SummonerBoost":    "cleanse",
+	"SummonerHaste":    "ghost",
+	"SummonerSmite":    "smite",
+	"SummonerMana":     "clarity",
+	"SummonerSnowball": "mark",
+}
+
+// positionCallouts Live Client pozisyonlarının sohbetteki kısaltmaları
+var positionCallouts = map[string]string{
+	"TOP":     "top",
+	"JUNGLE":  "jg",
+	"MIDDLE":  "mid",
+	"BOTTOM":  "adc",
+	"UTILITY": "sup",
+}
+
+// SpellKey "GeneratedTip_SummonerSpell_SummonerFlash_DisplayName" gibi ham isimden
+// "SummonerFlash" anahtarını çıkarır
+func SpellKey(spell lcu.LiveSpell) string {
+	for _, part := range strings.Split(spell.RawDisplayName, "_") {
+		if strings.HasPrefix(part, "Summoner") && part != "SummonerSpell" {
+			return part
+		}
+	}
+	return ""
+}
+
+// SpellHaste oyuncunun görünen item/rünlerinden sihirdar büyüsü hızlandırmasını hesaplar.
+// runeIDs sadece bilinen rünleri içerir (rakiplerin küçük rünleri görünmez).
+func SpellHaste(p lcu.LivePlayer, runeIDs []int) float64 {
+	haste := 0.0
+	for _, item := range p.Items {
+		if item.ItemID == ionianBootsItemID {
+			haste += ionianBootsHaste
+			break
+		}
+	}
+	for _, id := range runeIDs {
+		if id == cosmicInsightRuneID {
+			haste += cosmicInsightHaste
+			break
+		}
+	}
+	return haste
+}
+
+// SpellTimer kullanılmış bir sihirdar büyüsünün sayacı
+type SpellTimer struct {
+	Player   string // Oyuncu (summoner) adı
+	Champion string
+	Position string
+	Slot     int // 0: D, 1: F
+	SpellKey string
+	Spell    string // Ekranda görünen isim
+	UsedAt   float64
+	ReadyAt  float64
+	Haste    float64
+}
+
+// Remaining büyünün hazır olmasına kalan süre
+func (t SpellTimer) Remaining(gameTime float64) float64 {
+	if gameTime >= t.ReadyAt {
+		return 0
+	}
+	return t.ReadyAt - gameTime
+}
+
+// Callout takıma yazılacak "flash down mid 14:32" biçiminde mesaj üretir
+func (t SpellTimer) Callout() string {
+	spell := summonerSpellCallouts[t.SpellKey]
+	if spell == "" {
+		spell = strings.ToLower(t.Spell)
+	}
+	who := positionCallouts[t.Position]
+	if who == "" {
+		who = strings.ToLower(t.Champion)
+	}
+
+	ready := int(t.ReadyAt)
+	return fmt.Sprintf("%s down %s %02d:%02d", spell, who, ready/60, ready%60)
+}
+
+// SpellTracker rakip sihirdar büyülerinin bekleme sürelerini takip eder
+type SpellTracker struct {
+	mu     sync.Mutex
+	timers map[string]SpellTimer // player/slot -> sayaç
+}
+
+// NewSpellTracker yeni bir büyü takipçisi oluşturur
+func NewSpellTracker() *SpellTracker {
+	return &SpellTracker{timers: make(map[string]SpellTimer)}
+}
+
+func spellTimerKey(player string, slot int) string {
+	return fmt.Sprintf("%s/%d", player, slot)
+}
+
+// MarkUsed oyuncunun slot'taki (0 veya 1) büyüsünü gameTime anında kullanılmış işaretler
+func (t *SpellTracker) MarkUsed(p lcu.LivePlayer, slot int, gameTime float64, runeIDs []int) (SpellTimer, error) {
+	spell := p.SummonerSpells.SummonerSpellOne
+	if slot == 1 {
+		spell = p.SummonerSpells.SummonerSpellTwo
+	}
+
+	key := SpellKey(spell)
+	base, ok := summonerSpellCooldowns[key]
+	if !ok {
+		return SpellTimer{}, fmt.Errorf("bilinmeyen sihirdar büyüsü: %s", spell.DisplayName)
+	}
+
+	haste := SpellHaste(p, runeIDs)
+	timer := SpellTimer{
+		Player:   p.SummonerName,
+		Champion: p.ChampionName,
+		Position: p.Position,
+		Slot:     slot,
+		SpellKey: key,
+		Spell:    spell.DisplayName,
+		UsedAt:   gameTime,
+		ReadyAt:  gameTime + base*100/(100+haste),
+		Haste:    haste,
+	}
+
+	t.mu.Lock()
+	t.timers[spellTimerKey(p.SummonerName, slot)] = timer
+	t.mu.Unlock()
+
+	return timer, nil
+}
+
+// Clear yanlışlıkla işaretlenen bir sayacı siler
+func (t *SpellTracker) Clear(player string, slot int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.timers, spellTimerKey(player, slot))
+}
+
+// Timer oyuncunun slot'taki büyüsü için aktif sayacı döner
+func (t *SpellTracker) Timer(player string, slot int, gameTime float64) (SpellTimer, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	timer, ok := t.timers[spellTimerKey(player, slot)]
+	if !ok || timer.Remaining(gameTime) <= 0 {
+		return SpellTimer{}, false
+	}
+	return timer, true
+}
+
+// HandleEvent yeni oyunda sayaçları temizler (EventTracker aboneliği)
+func (t *SpellTracker) HandleEvent(ev Event) {
+	if _, ok := ev.(GameStartEvent); ok {
+		t.mu.Lock()
+		t.timers = make(map[string]SpellTimer)
+		t.mu.Unlock()
+	}
+}
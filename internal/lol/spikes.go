@@ -0,0 +1,139 @@
+package lol
+
+import (
+	"sync"
+
+	"lol-helper/internal/lcu"
+)
+
+// maxNotifications akışta tutulacak en fazla bildirim
+const maxNotifications = 50
+
+// powerSpikeLevels ultinin açıldığı/güçlendiği seviyeler
+var powerSpikeLevels = []int{6, 11, 16}
+
+// NotificationKind bildirim türü
+type NotificationKind string
+
+const (
+	NotificationItemCompleted NotificationKind = "ItemCompleted"
+	NotificationLevelSpike    NotificationKind = "LevelSpike"
+)
+
+// Notification bir oyuncunun güç artışı bildirimi
+type Notification struct {
+	ID       int
+	Kind     NotificationKind
+	GameTime float64
+	Player   string
+	Champion string
+	Team     string
+	ItemID   int
+	ItemName string
+	Level    int
+}
+
+// SpikeDetector oyuncuların item ve seviyelerini polling'ler arasında karşılaştırır,
+// efsanevi item tamamlama ve 6/11/16 seviye güç artışlarını bildirir
+type SpikeDetector struct {
+	mu            sync.Mutex
+	catalog       ItemCatalog
+	completed     map[string]map[int]bool // Oyuncu -> tamamlandığı bildirilmiş (veya ilk görüşte sahip olduğu) itemler
+	levels        map[string]int
+	notifications []Notification
+	nextID        int
+	lastGameTime  float64
+}
+
+// NewSpikeDetector yeni bir güç artışı dedektörü oluşturur
+func NewSpikeDetector() *SpikeDetector {
+	d := &SpikeDetector{nextID: 1}
+	d.reset()
+	return d
+}
+
+func (d *SpikeDetector) reset() {
+	d.completed = make(map[string]map[int]bool)
+	d.levels = make(map[string]int)
+	d.notifications = nil
+	d.lastGameTime = 0
+}
+
+// SetCatalog efsanevi itemleri ayırt etmek için item kataloğunu ayarlar
+func (d *SpikeDetector) SetCatalog(catalog ItemCatalog) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.catalog = catalog
+}
+
+// Update oyuncu listesini bir önceki polling ile karşılaştırır ve güncel bildirim akışını döner
+func (d *SpikeDetector) Update(players []lcu.LivePlayer, gameTime float64) []Notification {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if gameTime < d.lastGameTime {
+		d.reset()
+	}
+	d.lastGameTime = gameTime
+
+	for _, p := range players {
+		completed, known := d.completed[p.SummonerName]
+		if !known {
+			completed = make(map[int]bool, len(p.Items))
+			d.completed[p.SummonerName] = completed
+		}
+
+		prevLevel := d.levels[p.SummonerName]
+		d.levels[p.SummonerName] = p.Level
+
+		// İlk görüşte (uygulama oyun ortasında açıldıysa) mevcut durumu temel al
+		if !known {
+			for _, item := range p.Items {
+				completed[item.ItemID] = true
+			}
+			continue
+		}
+
+		// Her item oyun başına bir kez bildirilir; satıp yeniden almak veya geri alma
+		// ile yuva değiştirmek bildirimi tekrarlamaz
+		for _, item := range p.Items {
+			if completed[item.ItemID] || d.catalog == nil || !d.catalog.IsLegendary(item.ItemID) {
+				continue
+			}
+			completed[item.ItemID] = true
+			d.add(Notification{
+				Kind:     NotificationItemCompleted,
+				GameTime: gameTime,
+				Player:   p.SummonerName,
+				Champion: p.ChampionName,
+				Team:     p.Team,
+				ItemID:   item.ItemID,
+				ItemName: item.DisplayName,
+			})
+		}
+
+		for _, level := range powerSpikeLevels {
+			if prevLevel < level && p.Level >= level {
+				d.add(Notification{
+					Kind:     NotificationLevelSpike,
+					GameTime: gameTime,
+					Player:   p.SummonerName,
+					Champion: p.ChampionName,
+					Team:     p.Team,
+					Level:    level,
+				})
+			}
+		}
+	}
+
+	return append([]Notification(nil), d.notifications...)
+}
+
+func (d *SpikeDetector) add(n Notification) {
+	n.ID = d.nextID
+	d.nextID++
+	d.notifications = append(d.notifications, n)
+	if len(d.notifications) > maxNotifications {
+		d.notifications = d.notifications[len(d.notifications)-maxNotifications:]
+	}
+}
@@ -0,0 +1,268 @@
+package lol
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"lol-helper/internal/appdir"
+	"lol-helper/internal/lcu"
+)
+
+// statSaveEvery kaç örnekte bir kaydın diske yazılacağı
+const statSaveEvery = 10
+
+// StatKey zaman serisi tutulan şampiyon istatistikleri
+type StatKey string
+
+const (
+	StatAttackDamage    StatKey = "attackDamage"
+	StatAbilityPower    StatKey = "abilityPower"
+	StatArmor           StatKey = "armor"
+	StatMagicResist     StatKey = "magicResist"
+	StatAttackSpeed     StatKey = "attackSpeed"
+	StatAbilityHaste    StatKey = "abilityHaste"
+	StatLethality       StatKey = "lethality"
+	StatArmorPenPercent StatKey = "armorPenPercent"
+	StatMagicPenFlat    StatKey = "magicPenFlat"
+	StatMagicPenPercent StatKey = "magicPenPercent"
+	StatMaxHealth       StatKey = "maxHealth"
+)
+
+// StatKeys grafik ve karşılaştırmalarda kullanılan sıra
+var StatKeys = []StatKey{
+	StatAttackDamage, StatAbilityPower, StatArmor, StatMagicResist, StatAttackSpeed,
+	StatAbilityHaste, StatLethality, StatArmorPenPercent, StatMagicPenFlat, StatMagicPenPercent,
+	StatMaxHealth,
+}
+
+// StatComparisonMinutes oyun sonu karşılaştırmasında bakılan dakikalar
+var StatComparisonMinutes = []int{10, 15, 20}
+
+// StatSample tek bir polling anındaki şampiyon istatistikleri
+type StatSample struct {
+	GameTime float64             `json:"gameTime"`
+	Level    int                 `json:"level"`
+	Values   map[StatKey]float64 `json:"values"`
+}
+
+// newStatSample Live Client istatistiklerinden örnek oluşturur
+func newStatSample(gameTime float64, level int, s lcu.LiveChampionStats) StatSample {
+	return StatSample{
+		GameTime: gameTime,
+		Level:    level,
+		Values: map[StatKey]float64{
+			StatAttackDamage:    s.AttackDamage,
+			StatAbilityPower:    s.AbilityPower,
+			StatArmor:           s.Armor,
+			StatMagicResist:     s.MagicResist,
+			StatAttackSpeed:     s.AttackSpeed,
+			StatAbilityHaste:    s.AbilityHaste,
+			StatLethality:       s.PhysicalLethality,
+			StatArmorPenPercent: s.ArmorPenetrationPercent,
+			StatMagicPenFlat:    s.MagicPenetrationFlat,
+			StatMagicPenPercent: s.MagicPenetrationPercent,
+			StatMaxHealth:       s.MaxHealth,
+		},
+	}
+}
+
+// StatRecord bir oyundaki yerel oyuncunun istatistik geçmişi
+type StatRecord struct {
+	GameID    string       `json:"gameId"`
+	Champion  string       `json:"champion"`
+	StartedAt time.Time    `json:"startedAt"`
+	Items     []string     `json:"items"` // Son bilinen envanter
+	Samples   []StatSample `json:"samples"`
+}
+
+// At verilen oyun saniyesinde (veya hemen öncesinde) alınmış örneği döner
+func (r *StatRecord) At(gameTime float64) (StatSample, bool) {
+	var found StatSample
+	ok := false
+	for _, s := range r.Samples {
+		if s.GameTime > gameTime {
+			break
+		}
+		found, ok = s, true
+	}
+	return found, ok
+}
+
+// StatRecordSummary kayıtlı bir oyunun listelemede gösterilen özeti
+type StatRecordSummary struct {
+	GameID    string
+	Champion  string
+	StartedAt time.Time
+	Duration  float64
+}
+
+// StatComparison iki oyunun belirli bir dakikadaki istatistik karşılaştırması
+type StatComparison struct {
+	Minute int
+	A, B   map[StatKey]float64 // Oyun o dakikaya ulaşmadıysa nil
+}
+
+// CompareStats iki kaydı StatComparisonMinutes dakikalarında karşılaştırır
+func CompareStats(a, b *StatRecord) []StatComparison {
+	out := make([]StatComparison, 0, len(StatComparisonMinutes))
+	for _, minute := range StatComparisonMinutes {
+		c := StatComparison{Minute: minute}
+		t := float64(minute * 60)
+		if s, ok := a.At(t); ok && a.Samples[len(a.Samples)-1].GameTime >= t {
+			c.A = s.Values
+		}
+		if s, ok := b.At(t); ok && b.Samples[len(b.Samples)-1].GameTime >= t {
+			c.B = s.Values
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+// StatRecorder yerel oyuncunun istatistiklerini her polling'de kaydeder ve
+// oyun başına bir JSON dosyası olarak saklar
+type StatRecorder struct {
+	mu      sync.Mutex
+	dir     string
+	current *StatRecord
+	unsaved int
+}
+
+// NewStatRecorder dir klasörüne yazan bir kaydedici oluşturur
+func NewStatRecorder(dir string) *StatRecorder {
+	return &StatRecorder{dir: dir}
+}
+
+// Record yeni bir örnek ekler. Farklı bir oyun ID'si gelirse önceki oyun kaydedilip kapatılır.
+func (r *StatRecorder) Record(gameID, champion string, gameTime float64, p *lcu.LiveActivePlayer, items []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.current == nil || r.current.GameID != gameID {
+		r.flush()
+		r.current = r.load(gameID)
+		if r.current == nil {
+			r.current = &StatRecord{
+				GameID:    gameID,
+				Champion:  champion,
+				StartedAt: time.Now().Add(-time.Duration(gameTime) * time.Second),
+			}
+		}
+	}
+
+	// Uygulama yeniden başlatıldıysa zaten kaydedilmiş anları tekrar ekleme
+	if n := len(r.current.Samples); n > 0 && gameTime <= r.current.Samples[n-1].GameTime {
+		return
+	}
+
+	r.current.Items = items
+	r.current.Samples = append(r.current.Samples, newStatSample(gameTime, p.Level, p.ChampionStats))
+	r.unsaved++
+	if r.unsaved >= statSaveEvery {
+		r.flush()
+	}
+}
+
+// Current aktif oyunun kaydının kopyasını döner
+func (r *StatRecorder) Current() *StatRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.current == nil {
+		return nil
+	}
+	cp := *r.current
+	cp.Samples = append([]StatSample(nil), r.current.Samples...)
+	return &cp
+}
+
+// Flush bekleyen örnekleri diske yazar
+func (r *StatRecorder) Flush() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.flush()
+}
+
+// HandleEvent oyun bitince kaydı diske yazar (EventTracker aboneliği)
+func (r *StatRecorder) HandleEvent(ev Event) {
+	if _, ok := ev.(GameEndEvent); ok {
+		r.Flush()
+	}
+}
+
+func (r *StatRecorder) flush() {
+	if r.current == nil || r.unsaved == 0 || r.dir == "" {
+		return
+	}
+	data, err := json.Marshal(r.current)
+	if err != nil {
+		return
+	}
+	if err := appdir.WriteFile(r.path(r.current.GameID), data); err == nil {
+		r.unsaved = 0
+	}
+}
+
+func (r *StatRecorder) path(gameID string) string {
+	return filepath.Join(r.dir, gameID+".json")
+}
+
+func (r *StatRecorder) load(gameID string) *StatRecord {
+	rec, err := r.Load(gameID)
+	if err != nil {
+		return nil
+	}
+	return rec
+}
+
+// Load kayıtlı bir oyunu diskten okur
+func (r *StatRecorder) Load(gameID string) (*StatRecord, error) {
+	data, err := os.ReadFile(r.path(gameID))
+	if err != nil {
+		return nil, err
+	}
+	var rec StatRecord
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return nil, fmt.Errorf("istatistik kaydı okunamadı: %w", err)
+	}
+	return &rec, nil
+}
+
+// List kayıtlı oyunları yeniden eskiye döner. champion boş değilse sadece o şampiyonun oyunları döner.
+func (r *StatRecorder) List(champion string) ([]StatRecordSummary, error) {
+	if r.dir == "" {
+		return nil, nil
+	}
+	entries, err := os.ReadDir(r.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []StatRecordSummary
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".json") {
+			continue
+		}
+		rec, err := r.Load(strings.TrimSuffix(e.Name(), ".json"))
+		if err != nil || len(rec.Samples) == 0 {
+			continue
+		}
+		if champion != "" && rec.Champion != champion {
+			continue
+		}
+		out = append(out, StatRecordSummary{
+			GameID:    rec.GameID,
+			Champion:  rec.Champion,
+			StartedAt: rec.StartedAt,
+			Duration:  rec.Samples[len(rec.Samples)-1].GameTime,
+		})
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].StartedAt.After(out[j].StartedAt) })
+	return out, nil
+}
@@ -0,0 +1,145 @@
+package lol
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"lol-helper/internal/lcu"
+)
+
+const (
+	// tftHistoryCount maç geçmişinden alınan TFT oyunu sayısı
+	tftHistoryCount = 20
+	// tftTopPlacement "ilk 4" sayılan en kötü sıralama
+	tftTopPlacement = 4
+)
+
+// TFTPlayer TFT lobisindeki oyuncu
+type TFTPlayer struct {
+	Name  string
+	Local bool
+}
+
+// TFTTraitResult bir oyunda aktif olan özellik
+type TFTTraitResult struct {
+	Name  string
+	Units int
+	Tier  int // Aktif kademe (0 = aktif değil)
+}
+
+// TFTPlacement yerel oyuncunun geçmiş bir TFT oyunundaki sonucu
+type TFTPlacement struct {
+	MatchID   string
+	Placement int
+	Level     int
+	SetNumber int
+	QueueID   int
+	GameType  string // standard, pairs (Double Up), ...
+	Duration  time.Duration
+	PlayedAt  time.Time
+	Traits    []TFTTraitResult // Aktif özellikler, birim sayısına göre azalan
+}
+
+// TFTState TFT'ye özel oyun durumu
+type TFTState struct {
+	Lobby            []TFTPlayer
+	History          []TFTPlacement // Yeniden eskiye
+	AveragePlacement float64
+	Top4Rate         float64 // 0-1 arası
+}
+
+// NewTFTState lobi ve maç geçmişinden TFT durumunu oluşturur
+func NewTFTState(lobby []TFTPlayer, history []TFTPlacement) *TFTState {
+	state := &TFTState{Lobby: lobby, History: history}
+	if len(history) == 0 {
+		return state
+	}
+	total, top := 0, 0
+	for _, h := range history {
+		total += h.Placement
+		if h.Placement <= tftTopPlacement {
+			top++
+		}
+	}
+	state.AveragePlacement = float64(total) / float64(len(history))
+	state.Top4Rate = float64(top) / float64(len(history))
+	return state
+}
+
+// TFTHistoryFromMatches LCU maç geçmişinden puuid'li oyuncunun sonuçlarını çıkarır
+func TFTHistoryFromMatches(history *lcu.LolMatchHistoryTftMatchHistory, puuid string) []TFTPlacement {
+	if history == nil {
+		return nil
+	}
+	var out []TFTPlacement
+	for _, g := range history.Games {
+		for _, p := range g.JSON.Participants {
+			if p.Puuid != puuid {
+				continue
+			}
+			placement := TFTPlacement{
+				MatchID:   g.Metadata.MatchID,
+				Placement: int(p.Placement),
+				Level:     int(p.Level),
+				SetNumber: int(g.JSON.TftSetNumber),
+				QueueID:   int(g.JSON.QueueID),
+				GameType:  g.JSON.TftGameType,
+				Duration:  time.Duration(g.JSON.GameLength * float64(time.Second)),
+				PlayedAt:  time.UnixMilli(int64(g.JSON.GameDatetime)),
+			}
+			for _, t := range p.Traits {
+				if t.TierCurrent > 0 {
+					placement.Traits = append(placement.Traits, TFTTraitResult{Name: t.Name, Units: int(t.NumUnits), Tier: int(t.TierCurrent)})
+				}
+			}
+			sort.SliceStable(placement.Traits, func(i, j int) bool {
+				return placement.Traits[i].Units > placement.Traits[j].Units
+			})
+			out = append(out, placement)
+			break
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].PlayedAt.After(out[j].PlayedAt) })
+	return out
+}
+
+// tftLobbyFromLive Live Client oyuncu listesinden TFT lobisini oluşturur
+func tftLobbyFromLive(players []lcu.LivePlayer, localName string) []TFTPlayer {
+	lobby := make([]TFTPlayer, 0, len(players))
+	for _, p := range players {
+		name := p.SummonerName
+		if p.RiotIDGameName != "" {
+			name = p.RiotIDGameName
+		}
+		lobby = append(lobby, TFTPlayer{Name: name, Local: p.SummonerName == localName})
+	}
+	return lobby
+}
+
+// tftLobbyFromSession oyun akışı oturumundaki takım listesinden TFT lobisini oluşturur.
+// TFT'de bütün oyuncular teamOne'da gelir; alanlar şemada tanımlı olmadığı için ham JSON okunur.
+func tftLobbyFromSession(session *lcu.LolGameflowGameflowSession, puuid string) []TFTPlayer {
+	var lobby []TFTPlayer
+	for _, team := range [][]json.RawMessage{session.GameData.TeamOne, session.GameData.TeamTwo} {
+		for _, raw := range team {
+			var member struct {
+				Puuid        string `json:"puuid"`
+				GameName     string `json:"gameName"`
+				SummonerName string `json:"summonerName"`
+			}
+			if err := json.Unmarshal(raw, &member); err != nil {
+				continue
+			}
+			name := member.GameName
+			if name == "" {
+				name = member.SummonerName
+			}
+			if name == "" {
+				continue
+			}
+			lobby = append(lobby, TFTPlayer{Name: name, Local: puuid != "" && member.Puuid == puuid})
+		}
+	}
+	return lobby
+}
@@ -0,0 +1,520 @@
+package lol
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"lol-helper/internal/lcu"
+)
+
+// timelineSnapshotInterval iki durum kaydı arasındaki en kısa oyun süresi (saniye);
+// aradaki durumlar yazılmaz, son durum oyun sonunda veya kapanışta yazılır
+const timelineSnapshotInterval = 30
+
+// TimelineEntryType zaman çizelgesi dosyasındaki satır türü
+type TimelineEntryType string
+
+const (
+	TimelineHeaderEntry         TimelineEntryType = "header"
+	TimelineSnapshotEntry       TimelineEntryType = "snapshot"
+	TimelineEventEntry          TimelineEntryType = "event"
+	TimelineRecommendationEntry TimelineEntryType = "recommendation"
+	TimelineEndEntry            TimelineEntryType = "end"
+)
+
+// TimelineHeader oyunun ilk kaydedildiği andaki kimlik bilgileri
+type TimelineHeader struct {
+	GameID    string    `json:"gameId"`
+	StartedAt time.Time `json:"startedAt"`
+	Champion  string    `json:"champion"`
+	Summoner  string    `json:"summoner"`
+	LocalTeam string    `json:"localTeam"`
+}
+
+// TimelineEntry dosyadaki tek satır (JSON Lines)
+type TimelineEntry struct {
+	Type           TimelineEntryType `json:"type"`
+	At             time.Time         `json:"at"`
+	GameTime       float64           `json:"gameTime"`
+	Header         *TimelineHeader   `json:"header,omitempty"`
+	State          *GameState        `json:"state,omitempty"`
+	EventName      string            `json:"eventName,omitempty"`
+	Event          json.RawMessage   `json:"event,omitempty"`
+	Recommendation *Recommendation   `json:"recommendation,omitempty"`
+	Result         string            `json:"result,omitempty"`
+}
+
+// eventDecoders kaydedilmiş olayları olay adına göre tipli değere çevirir
+var eventDecoders = map[string]func([]byte) (Event, error){
+	"GameStart":           unmarshalEvent[GameStartEvent],
+	"MinionsSpawning":     unmarshalEvent[MinionsSpawningEvent],
+	"FirstBlood":          unmarshalEvent[FirstBloodEvent],
+	"ChampionKill":        unmarshalEvent[ChampionKillEvent],
+	"Multikill":           unmarshalEvent[MultikillEvent],
+	"Ace":                 unmarshalEvent[AceEvent],
+	"DragonKill":          unmarshalEvent[DragonKillEvent],
+	"HeraldKill":          unmarshalEvent[HeraldKillEvent],
+	"HordeKill":           unmarshalEvent[HordeKillEvent],
+	"BaronKill":           unmarshalEvent[BaronKillEvent],
+	"TurretKilled":        unmarshalEvent[TurretKilledEvent],
+	"InhibKilled":         unmarshalEvent[InhibKilledEvent],
+	"InhibRespawningSoon": unmarshalEvent[InhibRespawningSoonEvent],
+	"InhibRespawned":      unmarshalEvent[InhibRespawnedEvent],
+	"FirstBrick":          unmarshalEvent[FirstBrickEvent],
+	"GameEnd":             unmarshalEvent[GameEndEvent],
+}
+
+func unmarshalEvent[T Event](data []byte) (Event, error) {
+	var ev T
+	if err := json.Unmarshal(data, &ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
+
+// decodeTimelineEvent kaydedilmiş bir olayı çözer; bilinmeyen adlar UnknownEvent olur
+func decodeTimelineEvent(name string, data []byte) (Event, error) {
+	if decode, ok := eventDecoders[name]; ok {
+		return decode(data)
+	}
+	return unmarshalEvent[UnknownEvent](data)
+}
+
+// TimelineSnapshot zaman çizelgesindeki tek durum kaydı
+type TimelineSnapshot struct {
+	GameTime float64
+	State    *GameState
+}
+
+// TimelineRecommendation oyun sırasında yapılmış bir AI önerisi
+type TimelineRecommendation struct {
+	GameTime       float64
+	Recommendation Recommendation
+}
+
+// Timeline diskten okunmuş bir oyunun tüm kaydı
+type Timeline struct {
+	Header          TimelineHeader
+	Snapshots       []TimelineSnapshot
+	Events          []Event
+	Recommendations []TimelineRecommendation
+	Result          string // Win, Lose; oyun bitmeden kapandıysa boş
+}
+
+// Last son durum kaydını döner
+func (t *Timeline) Last() (*GameState, bool) {
+	if len(t.Snapshots) == 0 {
+		return nil, false
+	}
+	return t.Snapshots[len(t.Snapshots)-1].State, true
+}
+
+// Duration son kaydın oyun süresi
+func (t *Timeline) Duration() float64 {
+	if len(t.Snapshots) == 0 {
+		return 0
+	}
+	return t.Snapshots[len(t.Snapshots)-1].GameTime
+}
+
+// TimelineSummary kayıtlı bir oyunun listelemede gösterilen özeti
+type TimelineSummary struct {
+	Header   TimelineHeader
+	Path     string
+	Duration float64
+	Result   string
+}
+
+// TimelineRecorder oyun başına bir JSON Lines dosyasına durum, olay, AI önerisi ve
+// sonucu yazar. Uygulama oyun ortasında yeniden başlatılırsa mevcut dosyaya devam eder.
+// Dosya ilk durum kaydıyla açılır (başlık durumdan yazılır); o ana kadar gelen olaylar bekletilir.
+type TimelineRecorder struct {
+	mu           sync.Mutex
+	dir          string
+	gameID       string
+	file         *os.File
+	writer       *bufio.Writer
+	seenEvents   map[int]bool
+	pending      []Event    // Dosya açılmadan gelen olaylar
+	latest       *GameState // Aralık dolmadığı için henüz yazılmamış son durum
+	lastGameTime float64    // Son yazılan durumun oyun saniyesi
+	finished     bool
+}
+
+// NewTimelineRecorder dir klasörüne yazan bir kaydedici oluşturur
+func NewTimelineRecorder(dir string) *TimelineRecorder {
+	return &TimelineRecorder{dir: dir}
+}
+
+// Path oyunun zaman çizelgesi dosyasının yolu
+func (r *TimelineRecorder) Path(gameID string) string {
+	return filepath.Join(r.dir, gameID+".jsonl")
+}
+
+// Begin kaydediciyi oyuna hazırlar; önceki oyunun dosyası kapatılır ve görülen
+// olaylar sıfırlanır. Olaylar çekilmeden önce çağrılmalıdır ki ilk turun olayları
+// (GameStart, yeniden başlatmada kaçırılanlar) ve yeni oyunun küçük olay ID'leri kaybolmasın.
+func (r *TimelineRecorder) Begin(gameID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.begin(gameID)
+}
+
+// Snapshot güncel oyun durumunu kaydeder. Durum en fazla timelineSnapshotInterval
+// saniyede bir yazılır ve sadece raporun kullandığı alanları içerir (bkz. timelineState).
+func (r *TimelineRecorder) Snapshot(gameID string, state *GameState) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.dir == "" || gameID == "" {
+		return
+	}
+	r.begin(gameID)
+	if r.file == nil {
+		if err := r.open(state); err != nil {
+			return
+		}
+	}
+	if r.finished || float64(state.GameTime) <= r.lastGameTime {
+		return
+	}
+
+	snap := timelineState(state)
+	if r.lastGameTime >= 0 && float64(state.GameTime)-r.lastGameTime < timelineSnapshotInterval {
+		r.latest = snap
+		return
+	}
+	r.writeSnapshot(snap)
+}
+
+// writeSnapshot durum kaydını yazar
+func (r *TimelineRecorder) writeSnapshot(snap *GameState) {
+	r.lastGameTime = float64(snap.GameTime)
+	r.latest = nil
+	r.write(TimelineEntry{Type: TimelineSnapshotEntry, GameTime: r.lastGameTime, State: snap})
+}
+
+// timelineState durumun raporda kullanılan kısmını kopyalar: oyuncuların skor,
+// seviye ve itemleri, takım altını, rol hedefleri ve yama. Rünler, yetenekler,
+// hedef sayaçları, birikimli geçmişler ve öneriler her satırda tekrarlanmaz.
+func timelineState(state *GameState) *GameState {
+	snap := &GameState{
+		GameID:    state.GameID,
+		GameTime:  state.GameTime,
+		Mode:      state.Mode,
+		Patch:     state.Patch,
+		Champion:  state.Champion,
+		LocalTeam: state.LocalTeam,
+		Spectator: state.Spectator,
+		GoldEstimate: GoldEstimate{
+			PlayerValues: state.GoldEstimate.PlayerValues,
+			TeamValues:   state.GoldEstimate.TeamValues,
+		},
+		Benchmarks: state.Benchmarks,
+	}
+	if state.ActivePlayer != nil {
+		snap.ActivePlayer = &lcu.LiveActivePlayer{
+			SummonerName:   state.ActivePlayer.SummonerName,
+			RiotID:         state.ActivePlayer.RiotID,
+			RiotIDGameName: state.ActivePlayer.RiotIDGameName,
+			RiotIDTagLine:  state.ActivePlayer.RiotIDTagLine,
+			Level:          state.ActivePlayer.Level,
+		}
+	}
+	snap.AllPlayers = make([]lcu.LivePlayer, 0, len(state.AllPlayers))
+	for _, p := range state.AllPlayers {
+		items := make([]lcu.LiveItem, 0, len(p.Items))
+		for _, it := range p.Items {
+			items = append(items, lcu.LiveItem{ItemID: it.ItemID, DisplayName: it.DisplayName, Count: it.Count, Slot: it.Slot})
+		}
+		snap.AllPlayers = append(snap.AllPlayers, lcu.LivePlayer{
+			ChampionName:    p.ChampionName,
+			RawChampionName: p.RawChampionName,
+			IsBot:           p.IsBot,
+			Items:           items,
+			Level:           p.Level,
+			Position:        p.Position,
+			RiotID:          p.RiotID,
+			RiotIDGameName:  p.RiotIDGameName,
+			RiotIDTagLine:   p.RiotIDTagLine,
+			Scores:          p.Scores,
+			SummonerName:    p.SummonerName,
+			Team:            p.Team,
+		})
+	}
+	return snap
+}
+
+// HandleEvent olayı kaydeder, oyun sonu olayında sonucu yazıp dosyayı kapatır (EventTracker aboneliği)
+func (r *TimelineRecorder) HandleEvent(ev Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.gameID == "" || r.finished || r.seenEvents[ev.ID()] {
+		return
+	}
+	r.seenEvents[ev.ID()] = true
+	if r.file == nil {
+		r.pending = append(r.pending, ev)
+		return
+	}
+	r.recordEvent(ev)
+}
+
+// Recommendation oyun sırasında yapılan AI önerisini kaydeder
+func (r *TimelineRecorder) Recommendation(gameTime float64, rec Recommendation) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil || r.finished {
+		return
+	}
+	r.write(TimelineEntry{Type: TimelineRecommendationEntry, GameTime: gameTime, Recommendation: &rec})
+	r.flush()
+}
+
+// Close açık dosyayı diske yazıp kapatır
+func (r *TimelineRecorder) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.close()
+}
+
+// begin oyun değiştiyse önceki dosyayı kapatır ve kaydediciyi yeni oyun için sıfırlar
+func (r *TimelineRecorder) begin(gameID string) {
+	if r.dir == "" || gameID == "" || gameID == r.gameID {
+		return
+	}
+	r.close()
+	r.gameID = gameID
+	r.seenEvents = make(map[int]bool)
+	r.pending = nil
+	r.latest = nil
+	r.lastGameTime = -1
+	r.finished = false
+}
+
+// open oyunun dosyasını ekleme modunda açar. Dosya varsa kaydedilmiş olaylar ve
+// son oyun saniyesi okunur ki yeniden başlatmada tekrar yazılmasınlar; sonra
+// bekleyen olaylardan dosyada olmayanlar yazılır.
+func (r *TimelineRecorder) open(state *GameState) error {
+	path := r.Path(r.gameID)
+	if err := trimPartialLine(path); err != nil {
+		return fmt.Errorf("zaman çizelgesi onarılamadı: %w", err)
+	}
+	recorded := make(map[int]bool)
+	existing, err := LoadTimeline(path)
+	if err == nil {
+		for _, ev := range existing.Events {
+			recorded[ev.ID()] = true
+			r.seenEvents[ev.ID()] = true
+		}
+		r.lastGameTime = existing.Duration()
+		r.finished = existing.Result != ""
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("zaman çizelgesi açılamadı: %w", err)
+	}
+	r.file = f
+	r.writer = bufio.NewWriter(f)
+
+	if existing == nil {
+		summoner := ""
+		if state.ActivePlayer != nil {
+			summoner = state.ActivePlayer.SummonerName
+		}
+		r.write(TimelineEntry{Type: TimelineHeaderEntry, Header: &TimelineHeader{
+			GameID:    r.gameID,
+			StartedAt: time.Now().Add(-time.Duration(state.GameTime) * time.Second),
+			Champion:  state.Champion,
+			Summoner:  summoner,
+			LocalTeam: state.LocalTeam,
+		}})
+		r.flush()
+	}
+
+	pending := r.pending
+	r.pending = nil
+	for _, ev := range pending {
+		if !recorded[ev.ID()] && !r.finished {
+			r.recordEvent(ev)
+		}
+	}
+	return nil
+}
+
+// trimPartialLine önceki çalışma satır yazarken kapandıysa dosyanın sonundaki yarım
+// satırı siler. Silinmezse ekleme modunda yazılan ilk kayıt yarım satıra yapışır ve
+// LoadTimeline ikisini de okuyamaz.
+func trimPartialLine(path string) error {
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	// Son satır sonunu sondan geriye doğru ara
+	size := info.Size()
+	end := size
+	buf := make([]byte, 4096)
+	for end > 0 {
+		n := min(int64(len(buf)), end)
+		if _, err := f.ReadAt(buf[:n], end-n); err != nil {
+			return err
+		}
+		if i := bytes.LastIndexByte(buf[:n], '\n'); i >= 0 {
+			end = end - n + int64(i) + 1
+			break
+		}
+		end -= n
+	}
+	if end == size {
+		return nil
+	}
+	return f.Truncate(end)
+}
+
+// recordEvent olayı yazar; oyun sonu olayında sonucu da yazıp kaydı bitirir
+func (r *TimelineRecorder) recordEvent(ev Event) {
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return
+	}
+	r.write(TimelineEntry{Type: TimelineEventEntry, GameTime: ev.Time(), EventName: ev.Name(), Event: data})
+
+	if end, ok := ev.(GameEndEvent); ok {
+		// Rapordaki son skor tablosu oyunun sonundaki durumu göstersin
+		if r.latest != nil {
+			r.writeSnapshot(r.latest)
+		}
+		r.write(TimelineEntry{Type: TimelineEndEntry, GameTime: ev.Time(), Result: end.Result})
+		r.finished = true
+		r.flush()
+	}
+}
+
+func (r *TimelineRecorder) write(e TimelineEntry) {
+	if r.writer == nil {
+		return
+	}
+	e.At = time.Now()
+	data, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+	r.writer.Write(data)
+	r.writer.WriteByte('\n')
+
+	// Durum kayıtları sık geldiği için tamponda biriktirilir; kopma anında en fazla birkaç satır kaybolur
+	if r.writer.Buffered() > 64*1024 {
+		r.flush()
+	}
+}
+
+func (r *TimelineRecorder) flush() {
+	if r.writer != nil {
+		r.writer.Flush()
+	}
+}
+
+func (r *TimelineRecorder) close() {
+	r.gameID = ""
+	r.pending = nil
+	if r.file == nil {
+		return
+	}
+	if r.latest != nil && !r.finished {
+		r.writeSnapshot(r.latest)
+	}
+	r.flush()
+	r.file.Close()
+	r.file = nil
+	r.writer = nil
+}
+
+// LoadTimeline bir zaman çizelgesi dosyasını okur. Yarım kalmış son satır yok sayılır.
+func LoadTimeline(path string) (*Timeline, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	t := &Timeline{}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 1024*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var e TimelineEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue
+		}
+
+		switch e.Type {
+		case TimelineHeaderEntry:
+			if e.Header != nil {
+				t.Header = *e.Header
+			}
+		case TimelineSnapshotEntry:
+			if e.State != nil {
+				t.Snapshots = append(t.Snapshots, TimelineSnapshot{GameTime: e.GameTime, State: e.State})
+			}
+		case TimelineEventEntry:
+			if ev, err := decodeTimelineEvent(e.EventName, e.Event); err == nil {
+				t.Events = append(t.Events, ev)
+			}
+		case TimelineRecommendationEntry:
+			if e.Recommendation != nil {
+				t.Recommendations = append(t.Recommendations, TimelineRecommendation{GameTime: e.GameTime, Recommendation: *e.Recommendation})
+			}
+		case TimelineEndEntry:
+			t.Result = e.Result
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("zaman çizelgesi okunamadı: %w", err)
+	}
+	return t, nil
+}
+
+// ListTimelines dir klasöründeki kayıtlı oyunları yeniden eskiye döner
+func ListTimelines(dir string) ([]TimelineSummary, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []TimelineSummary
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".jsonl") {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		t, err := LoadTimeline(path)
+		if err != nil {
+			continue
+		}
+		out = append(out, TimelineSummary{Header: t.Header, Path: path, Duration: t.Duration(), Result: t.Result})
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Header.StartedAt.After(out[j].Header.StartedAt) })
+	return out, nil
+}
@@ -0,0 +1,168 @@
+package lol
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"lol-helper/internal/lcu"
+)
+
+func TestTimelineRecorderKeepsEventsBeforeFirstSnapshot(t *testing.T) {
+	rec := NewTimelineRecorder(t.TempDir())
+
+	rec.Begin("g1")
+	rec.HandleEvent(GameStartEvent{EventBase{EventID: 0, EventName: "GameStart", EventTime: 0.1}})
+	rec.Snapshot("g1", &GameState{GameTime: 3, Champion: "Ahri"})
+	rec.Close()
+
+	tl, err := LoadTimeline(rec.Path("g1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tl.Header.GameID != "g1" {
+		t.Errorf("header game ID = %q, want g1", tl.Header.GameID)
+	}
+	if len(tl.Events) != 1 {
+		t.Fatalf("got %d events, want 1", len(tl.Events))
+	}
+	if _, ok := tl.Events[0].(GameStartEvent); !ok {
+		t.Errorf("event = %T, want GameStartEvent", tl.Events[0])
+	}
+}
+
+func TestTimelineRecorderNewGameResetsSeenEvents(t *testing.T) {
+	rec := NewTimelineRecorder(t.TempDir())
+
+	// Önceki oyun GameEnd olmadan bitti (remake, çökme)
+	rec.Begin("g1")
+	rec.Snapshot("g1", &GameState{GameTime: 60})
+	rec.HandleEvent(GameStartEvent{EventBase{EventID: 0, EventName: "GameStart"}})
+
+	rec.Begin("g2")
+	rec.HandleEvent(GameStartEvent{EventBase{EventID: 0, EventName: "GameStart"}})
+	rec.HandleEvent(FirstBloodEvent{EventBase: EventBase{EventID: 1, EventName: "FirstBlood", EventTime: 90}, Recipient: "a"})
+	rec.Snapshot("g2", &GameState{GameTime: 3})
+	rec.Close()
+
+	tl, err := LoadTimeline(rec.Path("g2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tl.Events) != 2 {
+		t.Fatalf("got %d events in new game, want 2", len(tl.Events))
+	}
+}
+
+func TestTimelineRecorderSkipsEventsAlreadyOnDisk(t *testing.T) {
+	dir := t.TempDir()
+	first := NewTimelineRecorder(dir)
+	first.Begin("g1")
+	first.Snapshot("g1", &GameState{GameTime: 3})
+	first.HandleEvent(GameStartEvent{EventBase{EventID: 0, EventName: "GameStart"}})
+	first.Close()
+
+	// Uygulama oyun ortasında yeniden başlatıldı; olay takipçisi tüm geçmişi yeniden yayınlar
+	second := NewTimelineRecorder(dir)
+	second.Begin("g1")
+	second.HandleEvent(GameStartEvent{EventBase{EventID: 0, EventName: "GameStart"}})
+	second.HandleEvent(GameEndEvent{EventBase: EventBase{EventID: 1, EventName: "GameEnd", EventTime: 900}, Result: "Win"})
+	second.Snapshot("g1", &GameState{GameTime: 900})
+	second.Close()
+
+	tl, err := LoadTimeline(second.Path("g1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tl.Events) != 2 {
+		t.Errorf("got %d events, want 2", len(tl.Events))
+	}
+	if tl.Result != "Win" {
+		t.Errorf("result = %q, want Win", tl.Result)
+	}
+}
+
+func TestTimelineRecorderTrimsPartialLineOnReopen(t *testing.T) {
+	dir := t.TempDir()
+	first := NewTimelineRecorder(dir)
+	first.Begin("g1")
+	first.Snapshot("g1", &GameState{GameTime: 3})
+	first.HandleEvent(GameStartEvent{EventBase{EventID: 0, EventName: "GameStart"}})
+	first.Close()
+
+	// Önceki çalışma satırın ortasında kapandı
+	f, err := os.OpenFile(first.Path("g1"), os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString(`{"type":"event","gameTime":60,"eventNa`)
+	f.Close()
+
+	second := NewTimelineRecorder(dir)
+	second.Begin("g1")
+	second.HandleEvent(FirstBloodEvent{EventBase: EventBase{EventID: 1, EventName: "FirstBlood", EventTime: 90}, Recipient: "a"})
+	second.Snapshot("g1", &GameState{GameTime: 90})
+	second.Close()
+
+	tl, err := LoadTimeline(second.Path("g1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tl.Events) != 2 {
+		t.Fatalf("got %d events, want 2", len(tl.Events))
+	}
+	if _, ok := tl.Events[1].(FirstBloodEvent); !ok {
+		t.Errorf("event = %T, want FirstBloodEvent", tl.Events[1])
+	}
+}
+
+func TestTimelineRecorderThrottlesSnapshots(t *testing.T) {
+	rec := NewTimelineRecorder(t.TempDir())
+	rec.Begin("g1")
+	for gameTime := 3; gameTime <= 120; gameTime += 3 {
+		rec.Snapshot("g1", &GameState{
+			GameTime:   gameTime,
+			AllPlayers: []lcu.LivePlayer{{SummonerName: "a", Level: gameTime / 30, Runes: lcu.LiveRunes{Keystone: lcu.LiveRune{ID: 8112}}}},
+			Skills:     SkillState{Next: "Q"},
+		})
+	}
+	rec.Close()
+
+	tl, err := LoadTimeline(rec.Path("g1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var times []float64
+	for _, snap := range tl.Snapshots {
+		times = append(times, snap.GameTime)
+	}
+	want := []float64{3, 33, 63, 93, 120}
+	if fmt.Sprint(times) != fmt.Sprint(want) {
+		t.Errorf("snapshot times = %v, want %v", times, want)
+	}
+
+	last, _ := tl.Last()
+	if last.AllPlayers[0].Level != 4 {
+		t.Errorf("last level = %d, want 4", last.AllPlayers[0].Level)
+	}
+	if last.AllPlayers[0].Runes.Keystone.ID != 0 || last.Skills.Next != "" {
+		t.Error("snapshot kept fields the report does not read")
+	}
+}
+
+func TestTimelineRecorderWritesFinalSnapshotOnGameEnd(t *testing.T) {
+	rec := NewTimelineRecorder(t.TempDir())
+	rec.Begin("g1")
+	rec.Snapshot("g1", &GameState{GameTime: 1000})
+	rec.Snapshot("g1", &GameState{GameTime: 1010})
+	rec.HandleEvent(GameEndEvent{EventBase: EventBase{EventID: 40, EventName: "GameEnd", EventTime: 1011}, Result: "Lose"})
+	rec.Close()
+
+	tl, err := LoadTimeline(rec.Path("g1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tl.Duration() != 1010 || tl.Result != "Lose" {
+		t.Errorf("duration %v result %q, want 1010 Lose", tl.Duration(), tl.Result)
+	}
+}
@@ -0,0 +1,171 @@
+package report
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"strings"
+
+	"lol-helper/internal/i18n"
+	"lol-helper/internal/lcu"
+	"lol-helper/internal/lol"
+)
+
+const (
+	chartWidth   = 640
+	chartHeight  = 240
+	chartPadLeft = 56
+	chartPadTop  = 28
+	chartPadEdge = 16
+)
+
+var (
+	colorBlue = "#5aaae6"
+	colorRed  = "#e66e5a"
+)
+
+// point grafikteki tek nokta
+type point struct {
+	X, Y float64
+}
+
+// series grafikteki tek çizgi
+type series struct {
+	Name   string
+	Color  string
+	Points []point
+}
+
+// buildCharts takım altını ile yerel oyuncu ve koridor rakibinin CS ve seviye grafiklerini çizer
+func buildCharts(t *lol.Timeline, last *lol.GameState, local string) []Chart {
+	var order, chaos []point
+	for _, s := range t.Snapshots {
+		order = append(order, point{s.GameTime, float64(s.State.GoldEstimate.TeamValues["ORDER"])})
+		chaos = append(chaos, point{s.GameTime, float64(s.State.GoldEstimate.TeamValues["CHAOS"])})
+	}
+	charts := []Chart{{
+		Title: i18n.T("report.chart.teamGold"),
+		SVG: lineChartSVG([]series{
+			{Name: teamName("ORDER"), Color: colorBlue, Points: order},
+			{Name: teamName("CHAOS"), Color: colorRed, Points: chaos},
+		}),
+	}}
+
+	me, ok := findPlayer(last.AllPlayers, local)
+	if !ok {
+		return charts
+	}
+	names := []string{me.SummonerName}
+	if opp, ok := laneOpponent(last.AllPlayers, me); ok {
+		names = append(names, opp.SummonerName)
+	}
+
+	metrics := []struct {
+		title string
+		value func(lcu.LivePlayer) float64
+	}{
+		{i18n.T("report.col.cs"), func(p lcu.LivePlayer) float64 { return float64(p.Scores.CreepScore) }},
+		{i18n.T("report.col.level"), func(p lcu.LivePlayer) float64 { return float64(p.Level) }},
+	}
+	colors := []string{colorBlue, colorRed}
+	for _, m := range metrics {
+		var lines []series
+		for i, name := range names {
+			s := series{Name: name, Color: colors[i]}
+			for _, snap := range t.Snapshots {
+				if p, ok := findPlayer(snap.State.AllPlayers, name); ok {
+					s.Points = append(s.Points, point{snap.GameTime, m.value(p)})
+				}
+			}
+			lines = append(lines, s)
+		}
+		charts = append(charts, Chart{Title: m.title, SVG: lineChartSVG(lines)})
+	}
+	return charts
+}
+
+func findPlayer(players []lcu.LivePlayer, name string) (lcu.LivePlayer, bool) {
+	for _, p := range players {
+		if p.SummonerName == name {
+			return p, true
+		}
+	}
+	return lcu.LivePlayer{}, false
+}
+
+// laneOpponent aynı pozisyondaki rakip oyuncuyu bulur
+func laneOpponent(players []lcu.LivePlayer, me lcu.LivePlayer) (lcu.LivePlayer, bool) {
+	if me.Position == "" {
+		return lcu.LivePlayer{}, false
+	}
+	for _, p := range players {
+		if p.Team != me.Team && p.Position == me.Position {
+			return p, true
+		}
+	}
+	return lcu.LivePlayer{}, false
+}
+
+// lineChartSVG serileri eksenli, lejantlı bağımsız bir SVG olarak çizer
+func lineChartSVG(lines []series) string {
+	minX, maxX := math.Inf(1), math.Inf(-1)
+	minY, maxY := math.Inf(1), math.Inf(-1)
+	for _, s := range lines {
+		for _, p := range s.Points {
+			minX, maxX = math.Min(minX, p.X), math.Max(maxX, p.X)
+			minY, maxY = math.Min(minY, p.Y), math.Max(maxY, p.Y)
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="11">`,
+		chartWidth, chartHeight, chartWidth, chartHeight)
+	fmt.Fprintf(&b, `<rect width="100%%" height="100%%" fill="#0a141e"/>`)
+
+	if math.IsInf(minX, 1) {
+		fmt.Fprintf(&b, `<text x="%d" y="%d" fill="#c8beaa">%s</text></svg>`, chartPadLeft, chartHeight/2, html.EscapeString(i18n.T("report.chart.noData")))
+		return b.String()
+	}
+	if maxX == minX {
+		maxX = minX + 1
+	}
+	if maxY == minY {
+		maxY = minY + 1
+	}
+
+	plotW := float64(chartWidth - chartPadLeft - chartPadEdge)
+	plotH := float64(chartHeight - chartPadTop - chartPadEdge*2)
+	toX := func(x float64) float64 { return chartPadLeft + (x-minX)/(maxX-minX)*plotW }
+	toY := func(y float64) float64 { return chartPadTop + plotH - (y-minY)/(maxY-minY)*plotH }
+
+	// Eksenler ve etiketler
+	fmt.Fprintf(&b, `<g stroke="#5a5a5a"><line x1="%d" y1="%d" x2="%d" y2="%.1f"/><line x1="%d" y1="%.1f" x2="%.1f" y2="%.1f"/></g>`,
+		chartPadLeft, chartPadTop, chartPadLeft, chartPadTop+plotH,
+		chartPadLeft, chartPadTop+plotH, chartPadLeft+plotW, chartPadTop+plotH)
+	fmt.Fprintf(&b, `<g fill="#c8beaa"><text x="4" y="%d">%s</text><text x="4" y="%.1f">%s</text>`,
+		chartPadTop+4, formatValue(maxY), chartPadTop+plotH, formatValue(minY))
+	fmt.Fprintf(&b, `<text x="%d" y="%.1f">%s</text><text x="%.1f" y="%.1f" text-anchor="end">%s</text></g>`,
+		chartPadLeft, chartPadTop+plotH+14, formatGameTime(minX), chartPadLeft+plotW, chartPadTop+plotH+14, formatGameTime(maxX))
+
+	// Çizgiler ve lejant
+	legendX := float64(chartPadLeft)
+	for _, s := range lines {
+		coords := make([]string, 0, len(s.Points))
+		for _, p := range s.Points {
+			coords = append(coords, fmt.Sprintf("%.1f,%.1f", toX(p.X), toY(p.Y)))
+		}
+		fmt.Fprintf(&b, `<polyline fill="none" stroke="%s" stroke-width="2" points="%s"/>`, s.Color, strings.Join(coords, " "))
+		fmt.Fprintf(&b, `<text x="%.1f" y="16" fill="%s">■ %s</text>`, legendX, s.Color, html.EscapeString(s.Name))
+		legendX += float64(len([]rune(s.Name)))*7 + 28
+	}
+
+	b.WriteString(`</svg>`)
+	return b.String()
+}
+
+func formatValue(v float64) string {
+	if math.Abs(v) >= 1000 {
+		return fmt.Sprintf("%.1fk", v/1000)
+	}
+	return fmt.Sprintf("%.0f", v)
+}
@@ -0,0 +1,111 @@
+package report
+
+import (
+	"html/template"
+	"io"
+	"strings"
+
+	"lol-helper/internal/i18n"
+)
+
+var htmlFuncs = template.FuncMap{
+	"t":           i18n.T,
+	"lang":        func() string { return string(i18n.Current()) },
+	"time":        formatGameTime,
+	"result":      resultName,
+	"svg":         func(s string) template.HTML { return template.HTML(s) },
+	"uri":         func(s string) template.URL { return template.URL(s) },
+	"pct":         percent,
+	"join":        func(s []string) string { return strings.Join(s, ", ") },
+	"checkpoints": checkpointSummary,
+	"teamClass":   teamClass,
+}
+
+// teamClass takım adını CSS sınıfına çevirir
+func teamClass(name string) string {
+	for _, key := range teamKeys {
+		if name != "" && teamName(key) == name {
+			return key
+		}
+	}
+	return ""
+}
+
+// htmlTemplate tek dosyalık rapor; stiller, grafikler (SVG) ve ikonlar (data URI) içine gömülüdür
+var htmlTemplate = template.Must(template.New("report").Funcs(htmlFuncs).Parse(`<!DOCTYPE html>
+<html lang="{{lang}}">
+<head>
+<meta charset="utf-8">
+<title>{{.Header.Champion}} — {{result .Result}}</title>
+<style>
+body { background: #010a13; color: #c8beaa; font-family: sans-serif; margin: 24px; }
+h1, h2, h3 { color: #c89b3c; }
+table { border-collapse: collapse; margin-bottom: 16px; }
+th, td { border-bottom: 1px solid #1e2328; padding: 4px 8px; text-align: left; }
+th { color: #f0e6d2; }
+tr.local td { background: #1e2d3c; font-weight: bold; }
+img.item { width: 28px; height: 28px; vertical-align: middle; }
+.ORDER { color: #5aaae6; }
+.CHAOS { color: #e66e5a; }
+.chart { margin-bottom: 16px; }
+small { color: #787878; }
+</style>
+</head>
+<body>
+<h1>{{.Header.Champion}} — {{result .Result}}</h1>
+<p>{{t "report.label.game"}} <code>{{.Header.GameID}}</code> · {{.Header.StartedAt.Format "02.01.2006 15:04"}} · {{time .Duration}} · {{.Header.Summoner}}</p>
+
+<h2>{{t "report.section.scoreboard"}}</h2>
+{{range .Teams}}
+<h3 class="{{.Team}}">{{t "report.team" .Name}}</h3>
+<table>
+<tr><th>{{t "report.col.champion"}}</th><th>{{t "report.col.summoner"}}</th><th>{{t "report.col.level"}}</th><th>{{t "report.col.kda"}}</th><th>{{t "report.col.cs"}}</th><th>{{t "report.col.vision"}}</th><th>{{t "report.col.items"}}</th></tr>
+{{range .Players}}<tr{{if .Local}} class="local"{{end}}>
+<td>{{.Champion}}</td><td>{{.Name}}</td><td>{{.Level}}</td><td>{{.Kills}}/{{.Deaths}}/{{.Assists}}</td><td>{{.CS}}</td><td>{{printf "%.0f" .Vision}}</td>
+<td>{{range .Items}}{{if .DataURI}}<img class="item" src="{{uri .DataURI}}" alt="{{.Name}}" title="{{.Name}}">{{else}}{{.Name}} {{end}}{{end}}</td>
+</tr>{{end}}
+</table>
+{{end}}
+
+<h2>{{t "report.section.charts"}}</h2>
+{{range .Charts}}<div class="chart"><h3>{{.Title}}</h3>{{svg .SVG}}</div>
+{{end}}
+
+{{if .Objectives}}<h2>{{t "report.section.objectives"}}</h2>
+<table>
+<tr><th>{{t "report.col.time"}}</th><th>{{t "report.col.team"}}</th><th>{{t "report.col.event"}}</th></tr>
+{{range .Objectives}}<tr><td>{{time .GameTime}}</td><td class="{{teamClass .Team}}">{{.Team}}</td><td>{{.Text}}</td></tr>
+{{end}}</table>{{end}}
+
+{{if .Kills}}<h2>{{t "report.section.kills"}}</h2>
+<table>
+<tr><th>{{t "report.col.time"}}</th><th>{{t "report.col.team"}}</th><th>{{t "report.col.event"}}</th></tr>
+{{range .Kills}}<tr><td>{{time .GameTime}}</td><td class="{{teamClass .Team}}">{{.Team}}</td><td>{{.Text}}</td></tr>
+{{end}}</table>{{end}}
+
+{{if .Benchmarks}}<h2>{{t "report.section.benchmarks"}}</h2>
+<table>
+<tr><th>{{t "report.col.player"}}</th><th>{{t "report.col.champion"}}</th><th>{{t "report.col.role"}}</th><th>{{t "report.col.grade"}}</th><th>{{t "report.col.csPerMin"}}</th><th>{{t "report.col.visionPerMin"}}</th><th>{{t "report.col.kp"}}</th><th>{{t "report.col.checkpoints"}}</th></tr>
+{{range .Benchmarks}}<tr>
+<td>{{.Player}}</td><td>{{.Champion}}</td><td>{{.Position}}</td><td>{{.Grade}}</td>
+<td>{{printf "%.1f" .Result.CSPerMin}} / {{printf "%.1f" .Result.Target.CSPerMin}}</td>
+<td>{{printf "%.2f" .Result.VisionPerMin}} / {{printf "%.2f" .Result.Target.VisionPerMin}}</td>
+<td>{{pct .Result.KillParticipation}} / {{pct .Result.Target.KillParticipation}}</td>
+<td>{{checkpoints .}}</td>
+</tr>{{end}}
+</table>{{end}}
+
+{{if .Recommendations}}<h2>{{t "report.section.recommendations"}}</h2>
+<ul>
+{{range .Recommendations}}<li><b>{{time .GameTime}}</b> — {{.Recommendation.Strategy}} {{.Recommendation.Suggestion}}{{if .Recommendation.NextItems}} <small>({{t "report.recommendation.items" (join .Recommendation.NextItems)}})</small>{{end}}</li>
+{{end}}</ul>{{end}}
+
+<p><small>{{t "report.generatedAt" (.GeneratedAt.Format "02.01.2006 15:04")}}</small></p>
+</body>
+</html>
+`))
+
+// WriteHTML raporu tek başına açılabilen bir HTML dosyası olarak yazar
+func WriteHTML(w io.Writer, r *Report) error {
+	return htmlTemplate.Execute(w, r)
+}
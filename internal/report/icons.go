@@ -0,0 +1,29 @@
+package report
+
+import (
+	"strconv"
+
+	"lol-helper/internal/assets"
+)
+
+// IconSource rapora gömülecek item ikonlarını sağlar. patch oyunun oynandığı
+// yamadır; item ikonları yamalar arasında değişebilir.
+type IconSource interface {
+	ItemIcon(patch string, itemID int) ([]byte, error)
+}
+
+// AssetIcons ikonları uygulamanın ikon servisinden alır; arayüzle aynı disk ve
+// bellek önbelleği kullanılır
+type AssetIcons struct {
+	Service *assets.Service
+	// DefaultPatch zaman çizelgesinde yama kayıtlı değilse kullanılır (boşsa ikon eklenmez)
+	DefaultPatch string
+}
+
+// ItemIcon item ikonunu PNG olarak döner
+func (a AssetIcons) ItemIcon(patch string, itemID int) ([]byte, error) {
+	if patch == "" {
+		patch = a.DefaultPatch
+	}
+	return a.Service.Fetch(assets.Key{Kind: assets.KindItem, Patch: patch, Name: strconv.Itoa(itemID)})
+}
@@ -0,0 +1,146 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"lol-helper/internal/i18n"
+	"lol-helper/internal/lol"
+)
+
+// writeFile raporu verilen biçimde dosyaya yazar
+func writeFile(path string, r *Report, write func(io.Writer, *Report) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("rapor yazılamadı: %w", err)
+	}
+	if err := write(f, r); err != nil {
+		f.Close()
+		return fmt.Errorf("rapor yazılamadı: %w", err)
+	}
+	return f.Close()
+}
+
+// WriteMarkdown raporu Markdown olarak yazar. İkon ve grafikler data URI olarak gömülür.
+func WriteMarkdown(w io.Writer, r *Report) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s — %s\n\n", r.Header.Champion, resultName(r.Result))
+	fmt.Fprintf(&b, "- %s: `%s`\n", i18n.T("report.label.game"), r.Header.GameID)
+	fmt.Fprintf(&b, "- %s: %s\n", i18n.T("report.label.started"), r.Header.StartedAt.Format("02.01.2006 15:04"))
+	fmt.Fprintf(&b, "- %s: %s\n", i18n.T("report.label.duration"), formatGameTime(r.Duration))
+	fmt.Fprintf(&b, "- %s: %s\n\n", i18n.T("report.label.player"), r.Header.Summoner)
+
+	fmt.Fprintf(&b, "## %s\n\n", i18n.T("report.section.scoreboard"))
+	for _, team := range r.Teams {
+		fmt.Fprintf(&b, "### %s\n\n", i18n.T("report.team", team.Name))
+		mdHeader(&b, "report.col.champion", "report.col.summoner", "report.col.level", "report.col.kda",
+			"report.col.cs", "report.col.vision", "report.col.items")
+		for _, p := range team.Players {
+			name := mdEscape(p.Name)
+			if p.Local {
+				name = "**" + name + "**"
+			}
+			fmt.Fprintf(&b, "| %s | %s | %d | %d/%d/%d | %d | %.0f | %s |\n",
+				mdEscape(p.Champion), name, p.Level, p.Kills, p.Deaths, p.Assists, p.CS, p.Vision, mdItems(p.Items))
+		}
+		b.WriteString("\n")
+	}
+
+	fmt.Fprintf(&b, "## %s\n\n", i18n.T("report.section.charts"))
+	for _, c := range r.Charts {
+		fmt.Fprintf(&b, "### %s\n\n![%s](%s)\n\n", c.Title, c.Title, c.DataURI())
+	}
+
+	writeMarkdownTimeline(&b, i18n.T("report.section.objectives"), r.Objectives)
+	writeMarkdownTimeline(&b, i18n.T("report.section.kills"), r.Kills)
+
+	if len(r.Benchmarks) > 0 {
+		fmt.Fprintf(&b, "## %s\n\n", i18n.T("report.section.benchmarks"))
+		mdHeader(&b, "report.col.player", "report.col.champion", "report.col.role", "report.col.grade",
+			"report.col.csPerMin", "report.col.visionPerMin", "report.col.kp", "report.col.checkpoints")
+		for _, row := range r.Benchmarks {
+			res := row.Result
+			fmt.Fprintf(&b, "| %s | %s | %s | %s | %.1f / %.1f | %.2f / %.2f | %s / %s | %s |\n",
+				mdEscape(row.Player), mdEscape(row.Champion), row.Position, row.Grade,
+				res.CSPerMin, res.Target.CSPerMin,
+				res.VisionPerMin, res.Target.VisionPerMin,
+				percent(res.KillParticipation), percent(res.Target.KillParticipation),
+				checkpointSummary(row))
+		}
+		b.WriteString("\n")
+	}
+
+	if len(r.Recommendations) > 0 {
+		fmt.Fprintf(&b, "## %s\n\n", i18n.T("report.section.recommendations"))
+		for _, rec := range r.Recommendations {
+			fmt.Fprintf(&b, "- **%s** — %s", formatGameTime(rec.GameTime), rec.Recommendation.Strategy)
+			if rec.Recommendation.Suggestion != "" {
+				fmt.Fprintf(&b, " %s", rec.Recommendation.Suggestion)
+			}
+			if len(rec.Recommendation.NextItems) > 0 {
+				fmt.Fprintf(&b, " (%s)", i18n.T("report.recommendation.items", strings.Join(rec.Recommendation.NextItems, ", ")))
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+	}
+
+	fmt.Fprintf(&b, "_%s_\n", i18n.T("report.generatedAt", r.GeneratedAt.Format("02.01.2006 15:04")))
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeMarkdownTimeline(b *strings.Builder, title string, rows []TimelineRow) {
+	if len(rows) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "## %s\n\n", title)
+	mdHeader(b, "report.col.time", "report.col.team", "report.col.event")
+	for _, row := range rows {
+		fmt.Fprintf(b, "| %s | %s | %s |\n", formatGameTime(row.GameTime), row.Team, mdEscape(row.Text))
+	}
+	b.WriteString("\n")
+}
+
+// mdHeader tablo başlığını ve ayırıcı satırı yazar; sütun adları i18n anahtarlarıdır
+func mdHeader(b *strings.Builder, keys ...string) {
+	for _, key := range keys {
+		fmt.Fprintf(b, "| %s ", i18n.T(key))
+	}
+	b.WriteString("|\n" + strings.Repeat("|---", len(keys)) + "|\n")
+}
+
+func mdItems(items []Item) string {
+	parts := make([]string, 0, len(items))
+	for _, it := range items {
+		if it.DataURI != "" {
+			parts = append(parts, fmt.Sprintf("![%s](%s)", mdEscape(it.Name), it.DataURI))
+		} else {
+			parts = append(parts, mdEscape(it.Name))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// checkpointSummary 10/15/20. dakika CS farklarını "-0.8 / +0.2 / -" biçiminde döner
+func checkpointSummary(row BenchmarkRow) string {
+	parts := make([]string, 0, 3)
+	for _, minute := range lol.BenchmarkMinutes {
+		text := "-"
+		for _, c := range row.Checkpoints {
+			if c.Minute == minute {
+				text = fmt.Sprintf("%+.1f", c.CSGap)
+			}
+		}
+		parts = append(parts, text)
+	}
+	return strings.Join(parts, " / ")
+}
+
+func mdEscape(s string) string {
+	return strings.NewReplacer("|", "\\|", "*", "\\*", "_", "\\_").Replace(s)
+}
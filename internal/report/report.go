@@ -0,0 +1,308 @@
+// Package report kaydedilmiş oyun zaman çizelgelerinden paylaşılabilir,
+// dış bağlantısız Markdown ve HTML maç raporları üretir.
+package report
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"lol-helper/internal/i18n"
+	"lol-helper/internal/lcu"
+	"lol-helper/internal/lol"
+)
+
+// teamKeys rapordaki takımlar, gösterim sırasıyla
+var teamKeys = []string{"ORDER", "CHAOS"}
+
+// teamName takım anahtarının raporda gösterilen ismi; bilinmiyorsa boş
+func teamName(team string) string {
+	switch team {
+	case "ORDER":
+		return i18n.T("team.blue")
+	case "CHAOS":
+		return i18n.T("team.red")
+	}
+	return ""
+}
+
+// gradeName hedef derecesinin raporda gösterilen ismi
+func gradeName(grade lol.BenchmarkGrade) string {
+	switch grade {
+	case lol.GradeGood:
+		return i18n.T("report.grade.good")
+	case lol.GradeClose:
+		return i18n.T("report.grade.close")
+	case lol.GradeBehind:
+		return i18n.T("report.grade.behind")
+	}
+	return "-"
+}
+
+// Item skor tablosundaki tek item
+type Item struct {
+	ID      int
+	Name    string
+	DataURI string // İkon alınamadıysa boş
+}
+
+// PlayerLine skor tablosundaki tek oyuncu
+type PlayerLine struct {
+	Name     string
+	Champion string
+	Position string
+	Level    int
+	Kills    int
+	Deaths   int
+	Assists  int
+	CS       int
+	Vision   float64
+	Items    []Item
+	Local    bool
+}
+
+// TeamScoreboard bir takımın son skor tablosu
+type TeamScoreboard struct {
+	Team    string
+	Name    string
+	Players []PlayerLine
+}
+
+// Chart SVG olarak çizilmiş grafik
+type Chart struct {
+	Title string
+	SVG   string
+}
+
+// DataURI grafiğin img etiketinde kullanılabilecek hali
+func (c Chart) DataURI() string {
+	return "data:image/svg+xml;base64," + base64.StdEncoding.EncodeToString([]byte(c.SVG))
+}
+
+// TimelineRow hedef veya öldürme zaman çizelgesindeki tek satır
+type TimelineRow struct {
+	GameTime float64
+	Team     string // Takımın seçili dildeki adı; bilinmiyorsa boş
+	Text     string
+}
+
+// BenchmarkRow bir oyuncunun rol hedeflerine göre oyun sonu derecesi
+type BenchmarkRow struct {
+	Player      string
+	Champion    string
+	Position    string
+	Grade       string
+	Result      lol.BenchmarkResult
+	Checkpoints []lol.BenchmarkCheckpoint
+}
+
+// Report tek bir oyunun rapor verisi
+type Report struct {
+	Header          lol.TimelineHeader
+	Result          string
+	Duration        float64
+	GeneratedAt     time.Time
+	Teams           []TeamScoreboard
+	Charts          []Chart
+	Objectives      []TimelineRow
+	Kills           []TimelineRow
+	Benchmarks      []BenchmarkRow
+	Recommendations []lol.TimelineRecommendation
+}
+
+// Build zaman çizelgesinden rapor verisini hazırlar. icons nil ise item ikonları eklenmez;
+// ikonlar oyunun kaydedilen yamasından alınır.
+func Build(t *lol.Timeline, icons IconSource) (*Report, error) {
+	last, ok := t.Last()
+	if !ok {
+		return nil, fmt.Errorf("zaman çizelgesinde durum kaydı yok")
+	}
+
+	r := &Report{
+		Header:          t.Header,
+		Result:          t.Result,
+		Duration:        t.Duration(),
+		GeneratedAt:     time.Now(),
+		Recommendations: t.Recommendations,
+	}
+
+	local := t.Header.Summoner
+	if last.ActivePlayer != nil {
+		local = last.ActivePlayer.SummonerName
+	}
+
+	r.Teams = buildScoreboard(last.AllPlayers, local, icons, last.Patch)
+	r.Charts = buildCharts(t, last, local)
+
+	teams := teamLookup(last.AllPlayers)
+	r.Objectives, r.Kills = buildTimelines(t.Events, teams)
+	r.Benchmarks = buildBenchmarks(last)
+	return r, nil
+}
+
+func buildScoreboard(players []lcu.LivePlayer, local string, icons IconSource, patch string) []TeamScoreboard {
+	boards := make([]TeamScoreboard, 0, len(teamKeys))
+	for _, team := range teamKeys {
+		boards = append(boards, TeamScoreboard{Team: team, Name: teamName(team)})
+	}
+	for _, p := range players {
+		line := PlayerLine{
+			Name:     p.SummonerName,
+			Champion: p.ChampionName,
+			Position: p.Position,
+			Level:    p.Level,
+			Kills:    p.Scores.Kills,
+			Deaths:   p.Scores.Deaths,
+			Assists:  p.Scores.Assists,
+			CS:       p.Scores.CreepScore,
+			Vision:   p.Scores.WardScore,
+			Local:    p.SummonerName == local,
+		}
+		for _, item := range p.Items {
+			if item.ItemID == 0 {
+				continue
+			}
+			it := Item{ID: item.ItemID, Name: item.DisplayName}
+			if icons != nil {
+				if data, err := icons.ItemIcon(patch, item.ItemID); err == nil {
+					it.DataURI = "data:image/png;base64," + base64.StdEncoding.EncodeToString(data)
+				}
+			}
+			line.Items = append(line.Items, it)
+		}
+
+		for i := range boards {
+			if boards[i].Team == p.Team {
+				boards[i].Players = append(boards[i].Players, line)
+			}
+		}
+	}
+	return boards
+}
+
+// teamLookup olaylardaki isimleri (sihirdar adı veya Riot ID) takıma eşler
+func teamLookup(players []lcu.LivePlayer) map[string]string {
+	teams := make(map[string]string, len(players)*3)
+	for _, p := range players {
+		for _, name := range []string{p.SummonerName, p.RiotID, p.RiotIDGameName} {
+			if name != "" {
+				teams[name] = p.Team
+			}
+		}
+	}
+	return teams
+}
+
+func buildTimelines(events []lol.Event, teams map[string]string) (objectives, kills []TimelineRow) {
+	row := func(ev lol.Event, killer, text string) TimelineRow {
+		return TimelineRow{GameTime: ev.Time(), Team: teamName(teams[killer]), Text: text}
+	}
+	stolenSuffix := func(stolen bool) string {
+		if stolen {
+			return " " + i18n.T("report.event.stolen")
+		}
+		return ""
+	}
+
+	for _, ev := range events {
+		switch e := ev.(type) {
+		case lol.ChampionKillEvent:
+			text := fmt.Sprintf("%s → %s", e.Killer, e.Victim)
+			if len(e.Assisters) > 0 {
+				text += " " + i18n.T("report.event.assists", len(e.Assisters))
+			}
+			kills = append(kills, row(ev, e.Killer, text))
+		case lol.MultikillEvent:
+			kills = append(kills, row(ev, e.Killer, i18n.T("report.event.multikill", e.Killer, e.KillStreak)))
+		case lol.AceEvent:
+			kills = append(kills, TimelineRow{GameTime: ev.Time(), Team: teamName(e.AcingTeam), Text: i18n.T("report.event.ace")})
+		case lol.DragonKillEvent:
+			objectives = append(objectives, row(ev, e.Killer, i18n.T("report.event.dragon", e.DragonType, e.Killer)+stolenSuffix(e.Stolen)))
+		case lol.HeraldKillEvent:
+			objectives = append(objectives, row(ev, e.Killer, i18n.T("report.event.herald", e.Killer)+stolenSuffix(e.Stolen)))
+		case lol.HordeKillEvent:
+			objectives = append(objectives, row(ev, e.Killer, i18n.T("report.event.horde", e.Killer)+stolenSuffix(e.Stolen)))
+		case lol.BaronKillEvent:
+			objectives = append(objectives, row(ev, e.Killer, i18n.T("report.event.baron", e.Killer)+stolenSuffix(e.Stolen)))
+		case lol.TurretKilledEvent:
+			objectives = append(objectives, row(ev, e.Killer, i18n.T("report.event.turret", e.Turret, e.Killer)))
+		case lol.InhibKilledEvent:
+			objectives = append(objectives, row(ev, e.Killer, i18n.T("report.event.inhib", e.Inhib, e.Killer)))
+		}
+	}
+	return objectives, kills
+}
+
+func buildBenchmarks(last *lol.GameState) []BenchmarkRow {
+	var rows []BenchmarkRow
+	for _, p := range last.AllPlayers {
+		res, ok := last.Benchmarks[p.SummonerName]
+		if !ok {
+			continue
+		}
+		rows = append(rows, BenchmarkRow{
+			Player:      p.SummonerName,
+			Champion:    p.ChampionName,
+			Position:    p.Position,
+			Grade:       gradeName(res.Grade),
+			Result:      res,
+			Checkpoints: res.Checkpoints,
+		})
+	}
+	sort.SliceStable(rows, func(i, j int) bool { return rows[i].Position < rows[j].Position })
+	return rows
+}
+
+// Generate zaman çizelgesi dosyasından raporu üretir ve outDir'e <oyun>.md ve
+// <oyun>.html olarak yazar. Yazılan dosyaların yollarını döner.
+func Generate(timelinePath, outDir string, icons IconSource) (mdPath, htmlPath string, err error) {
+	t, err := lol.LoadTimeline(timelinePath)
+	if err != nil {
+		return "", "", err
+	}
+	r, err := Build(t, icons)
+	if err != nil {
+		return "", "", err
+	}
+
+	name := t.Header.GameID
+	if name == "" {
+		name = filepath.Base(timelinePath[:len(timelinePath)-len(filepath.Ext(timelinePath))])
+	}
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		return "", "", fmt.Errorf("rapor klasörü oluşturulamadı: %w", err)
+	}
+
+	mdPath = filepath.Join(outDir, name+".md")
+	htmlPath = filepath.Join(outDir, name+".html")
+	if err := writeFile(mdPath, r, WriteMarkdown); err != nil {
+		return "", "", err
+	}
+	if err := writeFile(htmlPath, r, WriteHTML); err != nil {
+		return "", "", err
+	}
+	return mdPath, htmlPath, nil
+}
+
+func formatGameTime(seconds float64) string {
+	s := int(seconds)
+	return fmt.Sprintf("%02d:%02d", s/60, s%60)
+}
+
+// percent 0-1 arası oranı seçili dilin yüzde biçiminde yazar ("%45" / "45%")
+func percent(v float64) string {
+	return i18n.T("report.percent", v*100)
+}
+
+func resultName(result string) string {
+	switch result {
+	case "Win":
+		return i18n.T("report.result.win")
+	case "Lose":
+		return i18n.T("report.result.lose")
+	}
+	return i18n.T("report.result.unknown")
+}
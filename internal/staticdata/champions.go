@@ -0,0 +1,171 @@
+// Package staticdata şampiyon ve item gibi oyun içi sabit verileri sağlar.
+package staticdata
+
+import (
+	_ "embed"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// championOverlayData Data Dragon'da olmayan kompozisyon verisi (hasar dağılımı, CC,
+// ön hat, özellikler, ARAM ayarları). Kayıtlar Data Dragon "key" alanındaki sayısal
+// ID ile eşlenir; "name" sadece dosyayı okuyan için tutulur.
+//
+//go:embed champions.json
+var championOverlayData []byte
+
+// Trait takım kompozisyonu açısından şampiyonun üstlendiği rol
+type Trait string
+
+const (
+	TraitEngage    Trait = "engage"    // Savaşı başlatan (ör. Malphite R)
+	TraitDisengage Trait = "disengage" // Savaştan kaçıran / dalışı kesen (ör. Janna)
+	TraitPoke      Trait = "poke"      // Uzaktan hasar (ör. Xerath)
+	TraitDive      Trait = "dive"      // Arka hatta dalan (ör. Vi, Camille)
+	TraitPick      Trait = "pick"      // Tek hedef yakalayan (ör. Thresh, Ahri)
+	TraitSplitpush Trait = "splitpush" // Yan koridorda baskı kuran (ör. Fiora)
+	TraitScaling   Trait = "scaling"   // Geç oyunda güçlenen (ör. Kassadin)
+	TraitPeel      Trait = "peel"      // Taşıyıcıyı koruyan (ör. Lulu)
+)
+
+// DamageProfile şampiyonun hasarının yüzde olarak fiziksel/büyü/gerçek dağılımı
+type DamageProfile struct {
+	Physical int `json:"physical"`
+	Magic    int `json:"magic"`
+	True     int `json:"true"`
+}
+
+// ARAMBalance Sonsuz Uçurum'daki denge çarpanları (1.0 = değişiklik yok)
+type ARAMBalance struct {
+	DamageDealt float64 `json:"damageDealt"`
+	DamageTaken float64 `json:"damageTaken"`
+	Healing     float64 `json:"healing"`
+	Shielding   float64 `json:"shielding"`
+}
+
+// Champion yüklü yamanın şampiyon kaydı: Data Dragon kimliği, adı ve sınıfları ile
+// gömülü kompozisyon verisi. Gömülü tabloda olmayan (ör. yeni çıkan) şampiyonların
+// hasar dağılımı ve ön hat bilgisi Data Dragon'dan tahmin edilir, özellikleri boştur.
+type Champion struct {
+	ID    int    // Sayısal anahtar (Live Client ve LCU'daki championId)
+	Key   string // Data Dragon kimliği ("MonkeyKing"); ikon dosya adı
+	Name  string // Seçili dilde
+	Title string
+	Tags  []string // Data Dragon sınıfları (Fighter, Tank, Mage...)
+
+	Damage    DamageProfile
+	HardCC    int  // Sersemletme, fırlatma, bastırma gibi sert kitle kontrolü sayısı
+	Frontline bool // Ön hatta hasar emebilir mi
+	Traits    []Trait
+	ARAM      *ARAMBalance // ARAM'da ayarı olmayan şampiyonlarda nil
+	Curated   bool         // Kompozisyon verisi gömülü tablodan geldi
+}
+
+// HasTrait şampiyonun verilen özelliğe sahip olup olmadığını döner
+func (c *Champion) HasTrait(t Trait) bool {
+	for _, tr := range c.Traits {
+		if tr == t {
+			return true
+		}
+	}
+	return false
+}
+
+// championOverlay champions.json'daki tek kayıt
+type championOverlay struct {
+	ID        int           `json:"id"`
+	Damage    DamageProfile `json:"damage"`
+	HardCC    int           `json:"hardCC"`
+	Frontline bool          `json:"frontline"`
+	Traits    []Trait       `json:"traits"`
+	ARAM      *ARAMBalance  `json:"aram,omitempty"`
+}
+
+var (
+	overlayOnce      sync.Once
+	championOverlays map[int]championOverlay
+)
+
+// defaultChampionOverlays gömülü kompozisyon verisini ID ile döner. Veri derlemeyle
+// geldiği için bozuk olması programlama hatasıdır.
+func defaultChampionOverlays() map[int]championOverlay {
+	overlayOnce.Do(func() {
+		var list []championOverlay
+		if err := json.Unmarshal(championOverlayData, &list); err != nil {
+			panic(err)
+		}
+		championOverlays = make(map[int]championOverlay, len(list))
+		for _, o := range list {
+			championOverlays[o.ID] = o
+		}
+	})
+	return championOverlays
+}
+
+// applyOverlay gömülü kompozisyon verisini, yoksa Data Dragon'dan tahmini uygular.
+// attack ve magic Data Dragon'un 0-10 arası "info" puanlarıdır.
+func (c *Champion) applyOverlay(attack, magic int) {
+	if o, ok := defaultChampionOverlays()[c.ID]; ok {
+		c.Damage, c.HardCC, c.Frontline, c.Traits, c.ARAM = o.Damage, o.HardCC, o.Frontline, o.Traits, o.ARAM
+		c.Curated = true
+		return
+	}
+	if attack+magic > 0 {
+		c.Damage.Physical = attack * 100 / (attack + magic)
+		c.Damage.Magic = 100 - c.Damage.Physical
+	}
+	for _, tag := range c.Tags {
+		if tag == "Tank" {
+			c.Frontline = true
+		}
+	}
+}
+
+// Champion sayısal anahtarla şampiyon döner
+func (g *GameData) Champion(id int) (*Champion, bool) {
+	ch, ok := g.champions[id]
+	return ch, ok
+}
+
+// ChampionByName şampiyonu seçili dildeki adıyla veya Data Dragon kimliğiyle
+// ("MonkeyKing") bulur. Büyük/küçük harf, boşluk ve noktalama dikkate alınmaz;
+// "Kai'Sa", "kaisa" ve "KaiSa" aynı şampiyonu verir.
+func (g *GameData) ChampionByName(name string) (*Champion, bool) {
+	ch, ok := g.championNames[normalizeName(name)]
+	return ch, ok
+}
+
+// Champions tüm şampiyonları isim sırasıyla döner
+func (g *GameData) Champions() []*Champion {
+	out := make([]*Champion, 0, len(g.champions))
+	for _, ch := range g.champions {
+		out = append(out, ch)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// indexChampionNames ters arama için şampiyon adlarını ve kimliklerini indeksler
+func (g *GameData) indexChampionNames() {
+	for _, ch := range g.champions {
+		g.championNames[normalizeName(ch.Key)] = ch
+	}
+	// Ad başka bir şampiyonun kimliğiyle çakışırsa ad kazanır
+	for _, ch := range g.champions {
+		g.championNames[normalizeName(ch.Name)] = ch
+	}
+}
+
+// normalizeName ismi sadece küçük harflerden oluşan karşılaştırma anahtarına çevirir
+func normalizeName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
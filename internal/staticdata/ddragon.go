@@ -0,0 +1,266 @@
+package staticdata
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"lol-helper/internal/appdir"
+)
+
+const (
+	ddragonURL = "https://ddragon.leagueoflegends.com"
+	// DefaultLocale Data Dragon verisinin varsayılan dili
+	DefaultLocale = "en_US"
+	// versionsFile önbellek klasöründe saklanan yama listesi (çevrimdışı sürüm bulmak için)
+	versionsFile = "versions.json"
+)
+
+// Store Data Dragon verisini indirir ve yama/dil başına diske önbellekler.
+// Bir yamanın dosyaları bir kez indirildikten sonra çevrimdışı da yüklenebilir.
+//
+// Önbellek düzeni: <dir>/versions.json ve <dir>/<yama>/<dil>/{item,champion,runesReforged,summoner}.json
+type Store struct {
+	dir    string // Boşsa disk önbelleği kullanılmaz
+	locale string
+	client *http.Client
+
+	loadMu        sync.Mutex // Aynı anda iki yükleme aynı dosyaları indirmesin
+	clientVersion string     // Bilinen son istemci sürümü
+	mu            sync.RWMutex
+	data          *GameData
+}
+
+// NewStore dir altında önbellekleyen bir Data Dragon deposu oluşturur.
+// locale boşsa DefaultLocale kullanılır.
+func NewStore(dir, locale string) *Store {
+	if locale == "" {
+		locale = DefaultLocale
+	}
+	return &Store{
+		dir:    dir,
+		locale: locale,
+		client: &http.Client{Timeout: 15 * time.Second},
+	}
+}
+
+// Current yüklü yamanın verisini döner; henüz yüklenmediyse nil
+func (s *Store) Current() *GameData {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data
+}
+
+// Version yüklü yamanın Data Dragon sürümünü döner; henüz yüklenmediyse boş
+func (s *Store) Version() string {
+	if g := s.Current(); g != nil {
+		return g.Version
+	}
+	return ""
+}
+
+// TotalCost yüklü yamadaki item'ın toplam fiyatını döner (lol.ItemCatalog)
+func (s *Store) TotalCost(itemID int) (int, bool) {
+	if g := s.Current(); g != nil {
+		return g.TotalCost(itemID)
+	}
+	return 0, false
+}
+
+// IsLegendary yüklü yamadaki item'ın efsanevi olup olmadığını döner (lol.ItemCatalog)
+func (s *Store) IsLegendary(itemID int) bool {
+	g := s.Current()
+	return g != nil && g.IsLegendary(itemID)
+}
+
+// Refresh istemci sürümüne (ör. LCU'dan "14.3.561.1234") uyan yamayı bulup yükler.
+// clientVersion boşsa bilinen son istemci sürümü, o da yoksa en yeni yama kullanılır;
+// böylece LCU'suz başlatılan bir yenileme istemci yamasını en yenisiyle ezmez.
+// Yama zaten yüklüyse tekrar yüklenmez.
+func (s *Store) Refresh(clientVersion string) (*GameData, error) {
+	s.loadMu.Lock()
+	defer s.loadMu.Unlock()
+
+	if clientVersion != "" {
+		s.clientVersion = clientVersion
+	}
+	version, err := s.ResolveVersion(s.clientVersion)
+	if err != nil {
+		return nil, err
+	}
+	if g := s.Current(); g != nil && g.Version == version && g.Locale == s.locale {
+		return g, nil
+	}
+	return s.load(version)
+}
+
+// ResolveVersion istemci sürümüne uyan Data Dragon yamasını bulur. Yama listesi
+// indirilemezse önbellekteki liste, o da yoksa önbellekteki en yeni yama kullanılır.
+func (s *Store) ResolveVersion(clientVersion string) (string, error) {
+	versions, err := s.Versions()
+	if err != nil {
+		cached := s.CachedVersions()
+		if len(cached) == 0 {
+			return "", err
+		}
+		versions = cached
+	}
+	if v := MatchVersion(clientVersion, versions); v != "" {
+		return v, nil
+	}
+	return versions[0], nil
+}
+
+// Versions Data Dragon yama listesini (yeniden eskiye) döner. İndirilen liste
+// önbelleğe yazılır; ağ yoksa önbellekteki liste döner.
+func (s *Store) Versions() ([]string, error) {
+	data, fetchErr := s.fetch(ddragonURL + "/api/versions.json")
+	if fetchErr == nil {
+		s.writeCache(versionsFile, data)
+	} else if cached, err := s.readCache(versionsFile); err == nil {
+		data = cached
+	} else {
+		return nil, fmt.Errorf("yama listesi alınamadı: %w", fetchErr)
+	}
+
+	var versions []string
+	if err := json.Unmarshal(data, &versions); err != nil {
+		return nil, fmt.Errorf("yama listesi geçersiz: %w", err)
+	}
+	if len(versions) == 0 {
+		return nil, errors.New("yama listesi boş")
+	}
+	return versions, nil
+}
+
+// CachedVersions mevcut dilde tüm dosyaları önbellekte olan yamaları yeniden eskiye döner
+func (s *Store) CachedVersions() []string {
+	if s.dir == "" {
+		return nil
+	}
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil
+	}
+	var out []string
+	for _, e := range entries {
+		if e.IsDir() && s.isCached(e.Name()) {
+			out = append(out, e.Name())
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return compareVersions(out[i], out[j]) > 0 })
+	return out
+}
+
+// Load yamanın verisini önbellekten okur, eksik dosyaları indirip önbelleğe yazar
+// ve yüklü yama olarak ayarlar
+func (s *Store) Load(version string) (*GameData, error) {
+	s.loadMu.Lock()
+	defer s.loadMu.Unlock()
+	return s.load(version)
+}
+
+func (s *Store) load(version string) (*GameData, error) {
+	files := make(map[string][]byte, len(ddragonFiles))
+	for _, name := range ddragonFiles {
+		rel := filepath.Join(version, s.locale, name)
+		data, err := s.readCache(rel)
+		if err != nil {
+			data, err = s.fetch(fmt.Sprintf("%s/cdn/%s/data/%s/%s", ddragonURL, version, s.locale, name))
+			if err != nil {
+				return nil, fmt.Errorf("%s indirilemedi (%s): %w", name, version, err)
+			}
+			s.writeCache(rel, data)
+		}
+		files[name] = data
+	}
+
+	g, err := parseGameData(version, s.locale, files)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.data = g
+	s.mu.Unlock()
+	return g, nil
+}
+
+// isCached yamanın mevcut dildeki tüm dosyalarının önbellekte olup olmadığını döner
+func (s *Store) isCached(version string) bool {
+	for _, name := range ddragonFiles {
+		if _, err := os.Stat(filepath.Join(s.dir, version, s.locale, name)); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Store) fetch(url string) ([]byte, error) {
+	resp, err := s.client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+func (s *Store) readCache(rel string) ([]byte, error) {
+	if s.dir == "" {
+		return nil, os.ErrNotExist
+	}
+	return os.ReadFile(filepath.Join(s.dir, rel))
+}
+
+// writeCache veriyi disk önbelleğine yazar. Önbellek yazılamazsa veri yine de bellekte kullanılır.
+func (s *Store) writeCache(rel string, data []byte) {
+	if s.dir != "" {
+		appdir.WriteFile(filepath.Join(s.dir, rel), data)
+	}
+}
+
+// MatchVersion istemci sürümüyle ("14.3.561.1234") aynı ana ve ara sürüme sahip
+// en yeni Data Dragon yamasını ("14.3.1") döner; bulunamazsa boş döner.
+// versions yeniden eskiye sıralı olmalıdır.
+func MatchVersion(clientVersion string, versions []string) string {
+	parts := strings.SplitN(strings.TrimSpace(clientVersion), ".", 3)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return ""
+	}
+	prefix := parts[0] + "." + parts[1] + "."
+	for _, v := range versions {
+		if strings.HasPrefix(v, prefix) {
+			return v
+		}
+	}
+	return ""
+}
+
+// compareVersions noktalı sürümleri sayısal olarak karşılaştırır (a>b ise pozitif)
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if x != y {
+			return x - y
+		}
+	}
+	return 0
+}
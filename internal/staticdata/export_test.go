@@ -0,0 +1,7 @@
+package staticdata
+
+// Dış test paketinin eşleştirme eşiklerine erişmesi için
+const (
+	ItemMatchThreshold = itemMatchThreshold
+	UnavailablePenalty = unavailablePenalty
+)
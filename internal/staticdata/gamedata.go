@@ -0,0 +1,383 @@
+package staticdata
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// legendaryMinCost efsanevi itemleri ikinci kademe botlardan ve diğer ucuz son itemlerden ayırır
+const legendaryMinCost = 2000
+
+// summonersRiftMapID Data Dragon'daki Sihirdar Vadisi harita numarası
+const summonersRiftMapID = 11
+
+// ItemGold item fiyat bilgisi
+type ItemGold struct {
+	Base        int  `json:"base"`  // Bileşenler hariç birleştirme ücreti
+	Total       int  `json:"total"` // Bileşenler dahil toplam fiyat
+	Sell        int  `json:"sell"`
+	Purchasable bool `json:"purchasable"`
+}
+
+// Item Data Dragon item kaydı
+type Item struct {
+	ID               int
+	Name             string
+	Plaintext        string
+	Description      string // Biçimlendirme etiketli uzun açıklama
+	Gold             ItemGold
+	Stats            map[string]float64 // "FlatPhysicalDamageMod" -> 40
+	Tags             []string
+	From             []int        // Bileşenler
+	Into             []int        // Dönüştüğü itemler
+	Depth            int          // Yapım ağacındaki kademe (bileşeni olmayan item 1)
+	Maps             map[int]bool // Harita numarası -> satın alınabilir mi
+	InStore          bool         // Mağazada listelenir (Ornn yükseltmeleri gibi itemlerde false)
+	RequiredChampion string       // Sadece bu şampiyonun alabildiği itemlerde dolu ("Gangplank")
+}
+
+// AvailableOn item'ın verilen haritada mağazadan satın alınıp alınamayacağını döner
+func (it *Item) AvailableOn(mapID int) bool {
+	return it.Gold.Purchasable && it.InStore && it.Maps[mapID]
+}
+
+// UsableBy item'ın şampiyon tarafından alınıp alınamayacağını döner (champion: Data Dragon kimliği veya adı)
+func (it *Item) UsableBy(champion string) bool {
+	return it.RequiredChampion == "" || strings.EqualFold(it.RequiredChampion, champion)
+}
+
+// IsLegendary item'ın tamamlanmış efsanevi item olup olmadığını döner: satın
+// alınabilir, başka bir item'a dönüşmez, tüketilebilir değil ve yeterince pahalı
+func (it *Item) IsLegendary() bool {
+	if !it.Gold.Purchasable || len(it.Into) > 0 || it.Gold.Total < legendaryMinCost {
+		return false
+	}
+	for _, tag := range it.Tags {
+		if tag == "Consumable" || tag == "Trinket" {
+			return false
+		}
+	}
+	return true
+}
+
+// Rune rün (perk) kaydı
+type Rune struct {
+	ID        int
+	Key       string
+	Name      string
+	Icon      string // Data Dragon img/ altındaki yol
+	ShortDesc string // Biçimlendirme etiketli kısa açıklama (seçili dilde)
+	LongDesc  string
+	Tree      int // Ait olduğu ağacın ID'si
+	Slot      int // Ağaçtaki yuva (0 = keystone)
+}
+
+// RuneTree rün ağacı; ilk yuva keystone yuvasıdır
+type RuneTree struct {
+	ID    int
+	Key   string
+	Name  string
+	Icon  string
+	Slots [][]Rune
+}
+
+// SummonerSpell sihirdar büyüsü kaydı
+type SummonerSpell struct {
+	ID       int    // Sayısal anahtar
+	Key      string // "SummonerFlash"; ikon dosya adı
+	Name     string
+	Cooldown float64
+	Modes    []string // Büyünün kullanılabildiği oyun modları
+}
+
+// GameData bir yamanın Data Dragon verisi: itemler, şampiyonlar, rünler ve sihirdar büyüleri
+type GameData struct {
+	Version string
+	Locale  string
+
+	items         map[int]*Item
+	itemList      []*Item // ID sırasıyla; ayrıştırmada bir kez sıralanır
+	itemsByName   map[string]*Item
+	champions     map[int]*Champion
+	championNames map[string]*Champion // Normalize edilmiş ad veya kimlik
+	runeTrees     []RuneTree
+	runes         map[int]*Rune
+	perkNames     map[string]int // Normalize edilmiş rün/stat shard adı veya anahtarı -> perk ID
+	treeNames     map[string]int // Normalize edilmiş ağaç adı veya anahtarı -> ağaç ID
+	shards        map[int]*StatShard
+	spells        map[int]*SummonerSpell
+}
+
+// Item ID ile item döner
+func (g *GameData) Item(id int) (*Item, bool) {
+	it, ok := g.items[id]
+	return it, ok
+}
+
+// ItemByName item'ı büyük/küçük harf duyarsız tam isimle bulur. Aynı isimde
+// birden fazla item varsa (ör. harita varyantları) satın alınabilir olan ve
+// sonra en küçük ID'li olan tercih edilir.
+func (g *GameData) ItemByName(name string) (*Item, bool) {
+	it, ok := g.itemsByName[strings.ToLower(strings.TrimSpace(name))]
+	return it, ok
+}
+
+// Components item'ın doğrudan bileşenlerini Data Dragon sırasıyla döner (aynı bileşen tekrar edebilir)
+func (g *GameData) Components(it *Item) []*Item {
+	out := make([]*Item, 0, len(it.From))
+	for _, id := range it.From {
+		if c, ok := g.items[id]; ok {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// Items tüm itemleri ID sırasıyla döner. Dilim paylaşılır; çağıran değiştirmemeli.
+func (g *GameData) Items() []*Item {
+	return g.itemList
+}
+
+// TotalCost item'ın bileşenleri dahil toplam fiyatını döner
+func (g *GameData) TotalCost(itemID int) (int, bool) {
+	it, ok := g.items[itemID]
+	if !ok {
+		return 0, false
+	}
+	return it.Gold.Total, true
+}
+
+// IsLegendary item'ın tamamlanmış efsanevi item olup olmadığını döner
+func (g *GameData) IsLegendary(itemID int) bool {
+	it, ok := g.items[itemID]
+	return ok && it.IsLegendary()
+}
+
+// SummonerSpell sayısal anahtarla sihirdar büyüsü döner
+func (g *GameData) SummonerSpell(id int) (*SummonerSpell, bool) {
+	sp, ok := g.spells[id]
+	return sp, ok
+}
+
+// ddragonFiles bir yama için indirilen Data Dragon dosyaları
+var ddragonFiles = []string{"item.json", "champion.json", "runesReforged.json", "summoner.json"}
+
+// parseGameData ddragonFiles sırasıyla verilen dosya içeriklerinden GameData oluşturur
+func parseGameData(version, locale string, files map[string][]byte) (*GameData, error) {
+	g := &GameData{
+		Version:       version,
+		Locale:        locale,
+		items:         make(map[int]*Item),
+		itemsByName:   make(map[string]*Item),
+		champions:     make(map[int]*Champion),
+		championNames: make(map[string]*Champion),
+		runes:         make(map[int]*Rune),
+		perkNames:     make(map[string]int),
+		treeNames:     make(map[string]int),
+		shards:        make(map[int]*StatShard),
+		spells:        make(map[int]*SummonerSpell),
+	}
+	if err := g.parseItems(files["item.json"]); err != nil {
+		return nil, fmt.Errorf("item.json geçersiz: %w", err)
+	}
+	if err := g.parseChampions(files["champion.json"]); err != nil {
+		return nil, fmt.Errorf("champion.json geçersiz: %w", err)
+	}
+	if err := g.parseRunes(files["runesReforged.json"]); err != nil {
+		return nil, fmt.Errorf("runesReforged.json geçersiz: %w", err)
+	}
+	if err := g.parseSpells(files["summoner.json"]); err != nil {
+		return nil, fmt.Errorf("summoner.json geçersiz: %w", err)
+	}
+	g.loadStatShards()
+	g.indexPerkNames()
+	return g, nil
+}
+
+func (g *GameData) parseItems(data []byte) error {
+	var doc struct {
+		Data map[string]struct {
+			Name             string             `json:"name"`
+			Plaintext        string             `json:"plaintext"`
+			Description      string             `json:"description"`
+			Gold             ItemGold           `json:"gold"`
+			Stats            map[string]float64 `json:"stats"`
+			Tags             []string           `json:"tags"`
+			From             []string           `json:"from"`
+			Into             []string           `json:"into"`
+			Depth            int                `json:"depth"`
+			Maps             map[string]bool    `json:"maps"`
+			InStore          *bool              `json:"inStore"` // Sadece false olduğunda yazılır
+			RequiredChampion string             `json:"requiredChampion"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	for idStr, raw := range doc.Data {
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			continue
+		}
+		it := &Item{
+			ID:               id,
+			Name:             raw.Name,
+			Plaintext:        raw.Plaintext,
+			Description:      raw.Description,
+			Gold:             raw.Gold,
+			Stats:            raw.Stats,
+			Tags:             raw.Tags,
+			From:             atoiAll(raw.From),
+			Into:             atoiAll(raw.Into),
+			Depth:            raw.Depth,
+			Maps:             make(map[int]bool, len(raw.Maps)),
+			InStore:          raw.InStore == nil || *raw.InStore,
+			RequiredChampion: raw.RequiredChampion,
+		}
+		if it.Depth == 0 {
+			it.Depth = 1
+		}
+		for m, ok := range raw.Maps {
+			if n, err := strconv.Atoi(m); err == nil {
+				it.Maps[n] = ok
+			}
+		}
+		g.items[id] = it
+		g.itemList = append(g.itemList, it)
+	}
+	sort.Slice(g.itemList, func(i, j int) bool { return g.itemList[i].ID < g.itemList[j].ID })
+
+	for _, it := range g.itemList {
+		key := strings.ToLower(it.Name)
+		if prev, ok := g.itemsByName[key]; ok && preferItem(prev, it) {
+			continue
+		}
+		g.itemsByName[key] = it
+	}
+	return nil
+}
+
+// preferItem aynı isimli iki itemden a'nın tercih edilip edilmeyeceğini döner
+// (Sihirdar Vadisi'nde satın alınabilir olan önce; Items() ID sırasıyla geldiği için eşitlikte a)
+func preferItem(a, b *Item) bool {
+	aBuy := a.Gold.Purchasable && a.Maps[summonersRiftMapID]
+	bBuy := b.Gold.Purchasable && b.Maps[summonersRiftMapID]
+	return aBuy || !bBuy
+}
+
+func (g *GameData) parseChampions(data []byte) error {
+	var doc struct {
+		Data map[string]struct {
+			ID    string   `json:"id"`
+			Key   string   `json:"key"`
+			Name  string   `json:"name"`
+			Title string   `json:"title"`
+			Tags  []string `json:"tags"`
+			Info  struct {
+				Attack int `json:"attack"`
+				Magic  int `json:"magic"`
+			} `json:"info"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	for _, raw := range doc.Data {
+		id, err := strconv.Atoi(raw.Key)
+		if err != nil {
+			continue
+		}
+		ch := &Champion{ID: id, Key: raw.ID, Name: raw.Name, Title: raw.Title, Tags: raw.Tags}
+		ch.applyOverlay(raw.Info.Attack, raw.Info.Magic)
+		g.champions[id] = ch
+	}
+	g.indexChampionNames()
+	return nil
+}
+
+func (g *GameData) parseRunes(data []byte) error {
+	var doc []struct {
+		ID    int    `json:"id"`
+		Key   string `json:"key"`
+		Name  string `json:"name"`
+		Icon  string `json:"icon"`
+		Slots []struct {
+			Runes []struct {
+				ID        int    `json:"id"`
+				Key       string `json:"key"`
+				Name      string `json:"name"`
+				Icon      string `json:"icon"`
+				ShortDesc string `json:"shortDesc"`
+				LongDesc  string `json:"longDesc"`
+			} `json:"runes"`
+		} `json:"slots"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	for _, rawTree := range doc {
+		tree := RuneTree{ID: rawTree.ID, Key: rawTree.Key, Name: rawTree.Name, Icon: rawTree.Icon}
+		for si, rawSlot := range rawTree.Slots {
+			slot := make([]Rune, 0, len(rawSlot.Runes))
+			for _, r := range rawSlot.Runes {
+				slot = append(slot, Rune{
+					ID: r.ID, Key: r.Key, Name: r.Name, Icon: r.Icon,
+					ShortDesc: r.ShortDesc, LongDesc: r.LongDesc,
+					Tree: rawTree.ID, Slot: si,
+				})
+			}
+			tree.Slots = append(tree.Slots, slot)
+		}
+		g.runeTrees = append(g.runeTrees, tree)
+	}
+	for ti := range g.runeTrees {
+		for si := range g.runeTrees[ti].Slots {
+			for ri := range g.runeTrees[ti].Slots[si] {
+				r := &g.runeTrees[ti].Slots[si][ri]
+				g.runes[r.ID] = r
+			}
+		}
+	}
+	return nil
+}
+
+func (g *GameData) parseSpells(data []byte) error {
+	var doc struct {
+		Data map[string]struct {
+			ID       string    `json:"id"`
+			Key      string    `json:"key"`
+			Name     string    `json:"name"`
+			Cooldown []float64 `json:"cooldown"`
+			Modes    []string  `json:"modes"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	for _, raw := range doc.Data {
+		id, err := strconv.Atoi(raw.Key)
+		if err != nil {
+			continue
+		}
+		sp := &SummonerSpell{ID: id, Key: raw.ID, Name: raw.Name, Modes: raw.Modes}
+		if len(raw.Cooldown) > 0 {
+			sp.Cooldown = raw.Cooldown[0]
+		}
+		g.spells[id] = sp
+	}
+	return nil
+}
+
+// atoiAll Data Dragon'un metin olarak verdiği ID listesini sayılara çevirir
+func atoiAll(ids []string) []int {
+	out := make([]int, 0, len(ids))
+	for _, s := range ids {
+		if n, err := strconv.Atoi(s); err == nil {
+			out = append(out, n)
+		}
+	}
+	return out
+}
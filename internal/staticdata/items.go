@@ -0,0 +1,190 @@
+package staticdata
+
+import (
+	_ "embed"
+	"encoding/json"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// itemAliasesData oyuncuların ve AI'ın kullandığı kısaltmalar ("BT", "IE", "dcap") -> item ID
+//
+//go:embed item_aliases.json
+var itemAliasesData []byte
+
+const (
+	// itemMatchThreshold bu güvenin altındaki eşleşmeler çözülmemiş sayılır
+	itemMatchThreshold = 0.7
+	// unavailablePenalty haritada satın alınamayan itemlerin skor çarpanı; tam
+	// isim yine eşleşir ama bulanık eşleşmede haritadaki item öne geçer
+	unavailablePenalty = 0.85
+)
+
+var (
+	itemAliasesOnce sync.Once
+	itemAliases     map[string]int
+)
+
+// defaultItemAliases gömülü takma ad tablosunu normalize edilmiş anahtarlarla döner
+func defaultItemAliases() map[string]int {
+	itemAliasesOnce.Do(func() {
+		var file struct {
+			Aliases map[string]int `json:"aliases"`
+		}
+		if err := json.Unmarshal(itemAliasesData, &file); err != nil {
+			panic(err)
+		}
+		itemAliases = make(map[string]int, len(file.Aliases))
+		for alias, id := range file.Aliases {
+			itemAliases[normalizeName(alias)] = id
+		}
+	})
+	return itemAliases
+}
+
+// ItemMatch bir item adının çözümlenmesi
+type ItemMatch struct {
+	Item       *Item   // En iyi aday; hiç aday yoksa nil
+	Confidence float64 // 0-1; 1 tam isim veya takma ad
+}
+
+// ResolveItem AI'ın veya kullanıcının yazdığı item adını yüklü yamanın itemine
+// çevirir. Sırasıyla takma ad, tam isim (noktalama ve boşluk farkı yok sayılır),
+// baş harfler ("IE"), kelime eşleşmesi ("Rabadon") ve düzeltme mesafesi
+// ("Infinty Edge") denenir. mapID sıfır değilse o haritada satın alınamayan
+// itemler geri plana düşer. Eşit skorda satın alınabilir olan, sonra küçük ID'li
+// item seçilir. Güven eşiğin altındaysa en yakın aday false ile döner.
+func (g *GameData) ResolveItem(name string, mapID int) (ItemMatch, bool) {
+	query := normalizeName(name)
+	if query == "" {
+		return ItemMatch{}, false
+	}
+	queryTokens := itemTokens(name)
+
+	var best ItemMatch
+	bestAvailable := false
+	alias, hasAlias := defaultItemAliases()[query]
+	for _, it := range g.Items() {
+		if it.Name == "" {
+			continue
+		}
+		var score float64
+		if hasAlias && it.ID == alias {
+			score = 1
+		} else {
+			score = itemNameScore(query, queryTokens, it.Name)
+		}
+		available := it.Gold.Purchasable && it.InStore
+		if mapID != 0 {
+			available = it.AvailableOn(mapID)
+		}
+		if !available {
+			score *= unavailablePenalty
+		}
+		// Items() ID sırasıyla geldiği için eşitlikte önceki (küçük ID) kalır
+		if score > best.Confidence || (score == best.Confidence && score > 0 && available && !bestAvailable) {
+			best = ItemMatch{Item: it, Confidence: score}
+			bestAvailable = available
+		}
+	}
+	return best, best.Item != nil && best.Confidence >= itemMatchThreshold
+}
+
+// itemNameScore sorgunun item adına ne kadar benzediğini 0-1 arasında döner
+func itemNameScore(query string, queryTokens []string, itemName string) float64 {
+	normalized := normalizeName(itemName)
+	if normalized == query {
+		return 1
+	}
+	nameTokens := itemTokens(itemName)
+
+	var score float64
+	if len(query) >= 2 && len(nameTokens) >= 2 && initials(nameTokens) == query {
+		score = 0.9
+	}
+
+	// Sorgunun veya adın tüm kelimeleri karşılandıysa, karşılanan kelime oranına göre
+	queryMatched, nameMatched := 0, 0
+	for _, q := range queryTokens {
+		for _, n := range nameTokens {
+			if tokenMatches(q, n) {
+				queryMatched++
+				break
+			}
+		}
+	}
+	for _, n := range nameTokens {
+		for _, q := range queryTokens {
+			if tokenMatches(q, n) {
+				nameMatched++
+				break
+			}
+		}
+	}
+	if queryMatched > 0 && (queryMatched == len(queryTokens) || nameMatched == len(nameTokens)) {
+		ratio := float64(queryMatched+nameMatched) / float64(len(queryTokens)+len(nameTokens))
+		score = max(score, 0.6+0.35*ratio)
+	}
+
+	// Yazım hataları için tüm ad üzerinden düzeltme mesafesi
+	longest := max(len([]rune(query)), len([]rune(normalized)))
+	similarity := 1 - float64(levenshtein(query, normalized))/float64(longest)
+	return max(score, 0.95*similarity)
+}
+
+// itemTokens adı küçük harfli kelimelere böler; kesme işaretleri kelimeyi bölmez ("Rabadon's" -> "rabadons")
+func itemTokens(name string) []string {
+	name = strings.NewReplacer("'", "", "’", "").Replace(strings.ToLower(name))
+	return strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// tokenMatches sorgu kelimesinin ad kelimesine uyup uymadığı: aynı, en az üç
+// harfli önek veya uzun kelimelerde tek harf yazım hatası
+func tokenMatches(q, n string) bool {
+	if q == n {
+		return true
+	}
+	if len([]rune(q)) >= 3 && strings.HasPrefix(n, q) {
+		return true
+	}
+	return len([]rune(q)) >= 5 && levenshtein(q, n) <= 1
+}
+
+// initials kelimelerin baş harfleri ("blade of the ruined king" -> "botrk")
+func initials(tokens []string) string {
+	var b strings.Builder
+	for _, t := range tokens {
+		for _, r := range t {
+			if unicode.IsLetter(r) {
+				b.WriteRune(r)
+			}
+			break
+		}
+	}
+	return b.String()
+}
+
+// levenshtein iki metin arasındaki harf ekleme, silme ve değiştirme sayısı
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,182 @@
+package staticdata
+
+import (
+	_ "embed"
+	"encoding/json"
+	"sync"
+)
+
+// statShardsData runesReforged.json'da olmayan stat shard tanımları (dil başına ad ve açıklama)
+//
+//go:embed statshards.json
+var statShardsData []byte
+
+// StatShard rün sayfasının alt üç satırındaki stat seçeneği (adaptif güç, can vb.)
+type StatShard struct {
+	ID          int
+	Key         string
+	Name        string // Seçili dilde
+	Description string // "+9 Uyarlanabilir Güç"
+	Icon        string // Data Dragon img/ altındaki yol
+}
+
+// statShardFile statshards.json biçimi; ad ve açıklama Data Dragon diline göre tutulur
+type statShardFile struct {
+	Rows   [][]int `json:"rows"` // Güncel sayfadaki saldırı, esneklik ve savunma satırları
+	Shards []struct {
+		ID          int               `json:"id"`
+		Key         string            `json:"key"`
+		Icon        string            `json:"icon"`
+		Name        map[string]string `json:"name"`
+		Description map[string]string `json:"description"`
+	} `json:"shards"`
+}
+
+var (
+	statShardsOnce sync.Once
+	statShards     statShardFile
+)
+
+// defaultStatShards gömülü stat shard tanımlarını döner. Veri derlemeyle geldiği
+// için bozuk olması programlama hatasıdır.
+func defaultStatShards() *statShardFile {
+	statShardsOnce.Do(func() {
+		if err := json.Unmarshal(statShardsData, &statShards); err != nil {
+			panic(err)
+		}
+	})
+	return &statShards
+}
+
+// RunePage bir oyuncunun katalog kayıtlarına çevrilmiş rün sayfası. Diğer
+// oyuncular için Live Client sadece keystone ve ağaçları verir; Runes ve
+// Shards bu durumda boştur.
+type RunePage struct {
+	Keystone  *Rune
+	Primary   *RuneTree
+	Secondary *RuneTree
+	Runes     []*Rune // Keystone hariç birincil ve ikincil ağaç rünleri, sayfa sırasıyla
+	Shards    []*StatShard
+}
+
+// Complete sayfanın tamamının (keystone dışındaki rünler dahil) bilinip bilinmediğini döner
+func (p RunePage) Complete() bool {
+	return len(p.Runes) > 0
+}
+
+// RuneTrees rün ağaçlarını Data Dragon sırasıyla döner
+func (g *GameData) RuneTrees() []RuneTree {
+	return g.runeTrees
+}
+
+// RuneTree ID ile rün ağacı döner
+func (g *GameData) RuneTree(id int) (*RuneTree, bool) {
+	for i := range g.runeTrees {
+		if g.runeTrees[i].ID == id {
+			return &g.runeTrees[i], true
+		}
+	}
+	return nil, false
+}
+
+// Rune ID ile rün döner
+func (g *GameData) Rune(id int) (*Rune, bool) {
+	r, ok := g.runes[id]
+	return r, ok
+}
+
+// StatShard ID ile stat shard döner
+func (g *GameData) StatShard(id int) (*StatShard, bool) {
+	sh, ok := g.shards[id]
+	return sh, ok
+}
+
+// StatShardRows güncel rün sayfasındaki stat shard satırlarını (saldırı, esneklik, savunma) döner
+func (g *GameData) StatShardRows() [][]*StatShard {
+	rows := make([][]*StatShard, 0, len(defaultStatShards().Rows))
+	for _, ids := range defaultStatShards().Rows {
+		row := make([]*StatShard, 0, len(ids))
+		for _, id := range ids {
+			if sh, ok := g.shards[id]; ok {
+				row = append(row, sh)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// ResolveRunePage ID'lerden rün sayfası oluşturur. perks keystone'u da içerebilir;
+// bilinmeyen ID'ler atlanır.
+func (g *GameData) ResolveRunePage(keystone, primary, secondary int, perks, shards []int) RunePage {
+	var page RunePage
+	page.Keystone, _ = g.Rune(keystone)
+	page.Primary, _ = g.RuneTree(primary)
+	page.Secondary, _ = g.RuneTree(secondary)
+	for _, id := range perks {
+		if r, ok := g.runes[id]; ok && id != keystone {
+			page.Runes = append(page.Runes, r)
+		}
+	}
+	for _, id := range shards {
+		if sh, ok := g.shards[id]; ok {
+			page.Shards = append(page.Shards, sh)
+		}
+	}
+	return page
+}
+
+// PerkID AI'ın veya kullanıcının yazdığı rün ya da stat shard adından perk ID'sini
+// bulur. Büyük/küçük harf, boşluk ve noktalama farkları yok sayılır; seçili dildeki
+// ad ve Data Dragon anahtarı ("PressTheAttack") kabul edilir.
+func (g *GameData) PerkID(name string) (int, bool) {
+	id, ok := g.perkNames[normalizeName(name)]
+	return id, ok
+}
+
+// RuneTreeID rün ağacı adından ("Precision", "Hassasiyet") ağaç ID'sini bulur
+func (g *GameData) RuneTreeID(name string) (int, bool) {
+	id, ok := g.treeNames[normalizeName(name)]
+	return id, ok
+}
+
+// loadStatShards gömülü stat shard tanımlarını GameData'nın diline göre yükler
+func (g *GameData) loadStatShards() {
+	for _, raw := range defaultStatShards().Shards {
+		g.shards[raw.ID] = &StatShard{
+			ID:          raw.ID,
+			Key:         raw.Key,
+			Name:        localized(raw.Name, g.Locale),
+			Description: localized(raw.Description, g.Locale),
+			Icon:        raw.Icon,
+		}
+	}
+}
+
+// indexPerkNames ters arama için rün, stat shard ve ağaç adlarını indeksler.
+// Aynı ada sahip stat shard rünü ezmez.
+func (g *GameData) indexPerkNames() {
+	for _, r := range g.runes {
+		g.perkNames[normalizeName(r.Name)] = r.ID
+		g.perkNames[normalizeName(r.Key)] = r.ID
+	}
+	for _, sh := range g.shards {
+		for _, key := range []string{normalizeName(sh.Name), normalizeName(sh.Key)} {
+			if _, taken := g.perkNames[key]; !taken {
+				g.perkNames[key] = sh.ID
+			}
+		}
+	}
+	for _, t := range g.runeTrees {
+		g.treeNames[normalizeName(t.Name)] = t.ID
+		g.treeNames[normalizeName(t.Key)] = t.ID
+	}
+}
+
+// localized dile göre metni, yoksa varsayılan dildekini döner
+func localized(texts map[string]string, locale string) string {
+	if s, ok := texts[locale]; ok {
+		return s
+	}
+	return texts[DefaultLocale]
+}
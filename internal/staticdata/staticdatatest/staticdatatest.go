@@ -0,0 +1,29 @@
+// Package staticdatatest testler için staticdata/testdata altındaki küçük Data Dragon
+// önbelleğini yükler (ağa çıkmaz; yamanın bütün dosyaları önbellekte).
+package staticdatatest
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"lol-helper/internal/staticdata"
+)
+
+// Version testdata önbelleğindeki tek yama
+const Version = "14.4.1"
+
+// Load test verisini yükler; hangi paketten çağrılırsa çağrılsın aynı dosyaları okur
+func Load(t testing.TB) *staticdata.GameData {
+	t.Helper()
+	_, file, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("staticdatatest: kaynak konumu bulunamadı")
+	}
+	dir := filepath.Join(filepath.Dir(file), "..", "testdata", "ddragon")
+	data, err := staticdata.NewStore(dir, staticdata.DefaultLocale).Load(Version)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
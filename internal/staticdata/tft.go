@@ -0,0 +1,86 @@
+package staticdata
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+
+	"lol-helper/internal/appdir"
+)
+
+// defaultTFTReference uygulamayla gelen TFT set özeti; veri klasörüne düzenlenebilir kopyası yazılır
+//
+//go:embed tft.json
+var defaultTFTReference []byte
+
+// TFTTrait bir TFT özelliği (sinerjisi) ve aktif olduğu birim sayıları
+type TFTTrait struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Breakpoints []int  `json:"breakpoints"`
+}
+
+// TFTUnit bir TFT birimi
+type TFTUnit struct {
+	Name   string   `json:"name"`
+	Cost   int      `json:"cost"`
+	Traits []string `json:"traits"`
+}
+
+// TFTReference bir setin özellik ve birim listesi
+type TFTReference struct {
+	Set    int        `json:"set"`
+	Name   string     `json:"name"`
+	Traits []TFTTrait `json:"traits"`
+	Units  []TFTUnit  `json:"units"`
+}
+
+// LoadTFTReference TFT set özetini path'ten okur. Dosya yoksa gömülü özet oraya
+// yazılır ki yeni sette kullanıcı güncelleyebilsin. path boşsa gömülü özet kullanılır.
+func LoadTFTReference(path string) (*TFTReference, error) {
+	data := defaultTFTReference
+	if path != "" {
+		fileData, err := os.ReadFile(path)
+		switch {
+		case err == nil:
+			data = fileData
+		case errors.Is(err, fs.ErrNotExist):
+			if err := appdir.WriteFile(path, defaultTFTReference); err != nil {
+				return nil, fmt.Errorf("TFT dosyası yazılamadı: %w", err)
+			}
+		default:
+			return nil, fmt.Errorf("TFT dosyası okunamadı: %w", err)
+		}
+	}
+
+	var ref TFTReference
+	if err := json.Unmarshal(data, &ref); err != nil {
+		return nil, fmt.Errorf("TFT dosyası geçersiz: %w", err)
+	}
+	return &ref, nil
+}
+
+// UnitsWithTrait özelliğe sahip birimleri maliyete, sonra isme göre sıralı döner
+func (r *TFTReference) UnitsWithTrait(trait string) []TFTUnit {
+	var out []TFTUnit
+	for _, u := range r.Units {
+		for _, t := range u.Traits {
+			if strings.EqualFold(t, trait) {
+				out = append(out, u)
+				break
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Cost != out[j].Cost {
+			return out[i].Cost < out[j].Cost
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
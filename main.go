@@ -1,9 +1,12 @@
 package main
 
 import (
+	"os"
+
 	"github.com/joho/godotenv"
 
 	"lol-helper/internal/gui"
+	"lol-helper/internal/i18n"
 )
 
 func main() {
@@ -11,6 +14,9 @@ func main() {
 	// .env.local varsa öncelikli olur
 	godotenv.Load(".env.local", ".env")
 
+	// Arayüz dili; Data Dragon verisi ve AI cevapları da bu dilde olur
+	i18n.SetLocale(i18n.Parse(os.Getenv("LOL_HELPER_LOCALE")))
+
 	// GUI'yi başlat
 	mainWindow := gui.NewMainWindow()
 	mainWindow.Start()